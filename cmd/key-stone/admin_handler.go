@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/gen/admin"
+	"github.com/neatflowcv/key-stone/internal/app/flow"
+)
+
+var _ admin.Service = (*AdminHandler)(nil)
+
+type AdminHandler struct {
+	service *flow.Service
+}
+
+func NewAdminHandler(
+	service *flow.Service,
+) *AdminHandler {
+	return &AdminHandler{
+		service: service,
+	}
+}
+
+func (h *AdminHandler) List(ctx context.Context, payload *admin.ListUsersPayload) (*admin.UserList, error) {
+	query := &flow.UserQuery{
+		Token:  strings.TrimPrefix(payload.Authorization, "Bearer "),
+		After:  "",
+		Search: "",
+		Limit:  payload.Limit,
+	}
+
+	if payload.After != nil {
+		query.After = *payload.After
+	}
+
+	if payload.Search != nil {
+		query.Search = *payload.Search
+	}
+
+	page, err := h.service.ListUsers(ctx, query)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	users := make([]*admin.AdminUser, 0, len(page.Users))
+	for _, profile := range page.Users {
+		users = append(users, adminUser(profile))
+	}
+
+	return &admin.UserList{
+		Users: users,
+		Next:  optional(page.Next),
+	}, nil
+}
+
+func (h *AdminHandler) Get(ctx context.Context, payload *admin.AdminUserPayload) (*admin.AdminUser, error) {
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+
+	profile, err := h.service.InspectUser(ctx, token, payload.Username)
+	if err != nil {
+		return nil, adminError(err)
+	}
+
+	return adminUser(profile), nil
+}
+
+func (h *AdminHandler) Delete(ctx context.Context, payload *admin.AdminUserPayload) error {
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+
+	err := h.service.ForceDeleteUser(ctx, token, payload.Username)
+	if err != nil {
+		return adminError(err)
+	}
+
+	return nil
+}
+
+func (h *AdminHandler) Disable(ctx context.Context, payload *admin.AdminUserPayload) error {
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+
+	err := h.service.DisableUser(ctx, token, payload.Username)
+	if err != nil {
+		return adminError(err)
+	}
+
+	return nil
+}
+
+func (h *AdminHandler) Enable(ctx context.Context, payload *admin.AdminUserPayload) error {
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+
+	err := h.service.EnableUser(ctx, token, payload.Username)
+	if err != nil {
+		return adminError(err)
+	}
+
+	return nil
+}
+
+func (h *AdminHandler) ResetPassword(ctx context.Context, payload *admin.AdminUserPayload) error {
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+
+	err := h.service.ForcePasswordReset(ctx, token, payload.Username)
+	if err != nil {
+		return adminError(err)
+	}
+
+	return nil
+}
+
+func (h *AdminHandler) Unlock(ctx context.Context, payload *admin.AdminUserPayload) error {
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+
+	err := h.service.UnlockUser(ctx, token, payload.Username)
+	if err != nil {
+		return adminError(err)
+	}
+
+	return nil
+}
+
+func (h *AdminHandler) SetEmail(ctx context.Context, payload *admin.AdminEmailPayload) error {
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+
+	err := h.service.SetUserEmail(ctx, &flow.EmailChange{
+		Token:    token,
+		Username: payload.Username,
+		Email:    payload.Email,
+		Verified: payload.Verified,
+	})
+	if err != nil {
+		return adminError(err)
+	}
+
+	return nil
+}
+
+// adminError maps the errors the admin methods of flow.Service share.
+func adminError(err error) error {
+	switch {
+	case errors.Is(err, flow.ErrEmailInvalid):
+		return admin.MakeBadRequest(err)
+	case errors.Is(err, flow.ErrTokenInvalid):
+		return admin.MakeUnauthorized(err)
+	case errors.Is(err, flow.ErrForbidden):
+		return admin.MakeForbidden(err)
+	case errors.Is(err, flow.ErrUserNotFound):
+		return admin.MakeNotFound(err)
+	case errors.Is(err, flow.ErrNotImplemented):
+		return admin.MakeNotImplemented(err)
+	default:
+		return admin.MakeInternalServerError(err)
+	}
+}
+
+func adminUser(profile *flow.User) *admin.AdminUser {
+	attributes := profile.Attributes
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	return &admin.AdminUser{
+		Username:      profile.Username,
+		Email:         optional(profile.Email),
+		EmailVerified: profile.EmailVerified,
+		Disabled:      profile.Disabled,
+		LockedUntil:   optionalTime(profile.LockedUntil),
+		DisplayName:   optional(profile.DisplayName),
+		Locale:        optional(profile.Locale),
+		Attributes:    attributes,
+		CreatedAt:     optionalTime(profile.CreatedAt),
+		UpdatedAt:     optionalTime(profile.UpdatedAt),
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/breachchecker"
+	"github.com/neatflowcv/key-stone/internal/pkg/breachchecker/bloom"
+	"github.com/neatflowcv/key-stone/internal/pkg/breachchecker/hibp"
+	"github.com/urfave/cli/v3"
+)
+
+// newBreachChecker returns nil if neither breached passwords nor a filter of them are configured.
+func newBreachChecker(breachedPasswords string, breachFilter string) (breachchecker.Checker, error) {
+	switch {
+	case breachedPasswords != "" && breachFilter != "":
+		return nil, ErrAmbiguousBreachChecker
+	case breachedPasswords != "":
+		checker, err := hibp.NewChecker(breachedPasswords)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create breach checker: %w", err)
+		}
+
+		return checker, nil
+	case breachFilter != "":
+		checker, err := bloom.NewChecker(breachFilter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create breach checker: %w", err)
+		}
+
+		return checker, nil
+	default:
+		return nil, nil //nolint:nilnil
+	}
+}
+
+// newBreachFilterCommand builds a Bloom filter from a Have I Been Pwned download, which is a fraction of its size.
+func newBreachFilterCommand() *cli.Command {
+	const flagFalsePositiveRate = "false-positive-rate"
+
+	return &cli.Command{ //nolint:exhaustruct
+		Name:      "build-breach-filter",
+		Usage:     "Builds a Bloom filter of breached passwords from a Have I Been Pwned download",
+		ArgsUsage: "<download file or directory> <filter file>",
+		Flags: []cli.Flag{
+			&cli.FloatFlag{ //nolint:exhaustruct
+				Name:  flagFalsePositiveRate,
+				Usage: "The share of passwords that are rejected although they were never breached",
+				Value: 0.001, //nolint:mnd
+			},
+		},
+		Action: func(_ context.Context, c *cli.Command) error {
+			if c.Args().Len() != 2 { //nolint:mnd
+				return ErrNoBreachFilterFiles
+			}
+
+			return buildBreachFilter(c.Args().Get(0), c.Args().Get(1), c.Float(flagFalsePositiveRate))
+		},
+	}
+}
+
+func buildBreachFilter(download string, path string, falsePositiveRate float64) error {
+	var count uint64
+
+	err := hibp.Walk(download, func([sha1.Size]byte) error {
+		count++
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to count breached passwords: %w", err)
+	}
+
+	filter := bloom.NewFilter(count, falsePositiveRate)
+
+	err = hibp.Walk(download, func(digest [sha1.Size]byte) error {
+		filter.Add(digest)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add breached passwords: %w", err)
+	}
+
+	file, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("failed to create filter file: %w", err)
+	}
+	defer file.Close()
+
+	_, err = filter.WriteTo(file)
+	if err != nil {
+		return fmt.Errorf("failed to write filter file: %w", err)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close filter file: %w", err)
+	}
+
+	log.Printf("added %d breached passwords to %s", count, path)
+
+	return nil
+}
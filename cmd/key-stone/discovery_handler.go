@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+
+	"github.com/neatflowcv/key-stone/gen/discovery"
+	"github.com/neatflowcv/key-stone/internal/app/flow"
+)
+
+var _ discovery.Service = (*DiscoveryHandler)(nil)
+
+type DiscoveryHandler struct {
+	service *flow.Service
+}
+
+func NewDiscoveryHandler(
+	service *flow.Service,
+) *DiscoveryHandler {
+	return &DiscoveryHandler{
+		service: service,
+	}
+}
+
+func (h *DiscoveryHandler) Jwks(ctx context.Context) (*discovery.JSONWebKeySet, error) {
+	keys := []*discovery.JSONWebKey{}
+
+	for _, key := range h.service.ListPublicKeys(ctx) {
+		keys = append(keys, &discovery.JSONWebKey{
+			Kty: key.KeyType,
+			Use: optional(key.Use),
+			Alg: optional(key.Algorithm),
+			Kid: optional(key.KeyID),
+			N:   optional(key.N),
+			E:   optional(key.E),
+			Crv: optional(key.Curve),
+			X:   optional(key.X),
+			Y:   optional(key.Y),
+		})
+	}
+
+	return &discovery.JSONWebKeySet{
+		Keys: keys,
+	}, nil
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
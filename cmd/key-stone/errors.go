@@ -0,0 +1,28 @@
+package main
+
+import "errors"
+
+var (
+	ErrNoAccessKey   = errors.New("either a public key or a signing key file is required")
+	ErrNoPrivateKey  = errors.New("either a private key or a refresh key is required")
+	ErrInvalidClient = errors.New("client must be given as client_id:client_secret")
+
+	ErrUnknownRepository = errors.New("repository must be file, sqlite, postgres or bolt")
+	ErrUnknownHasher     = errors.New("password hasher must be argon2id or bcrypt")
+	ErrInvalidPepper     = errors.New("pepper must be given as id:key")
+	ErrInvalidRefreshKey = errors.New("refresh key must be given as id:secret")
+
+	ErrNoImportFile        = errors.New("import needs exactly one file")
+	ErrUnknownImportFormat = errors.New("import format must be htpasswd or ldif")
+	ErrInvalidImportFile   = errors.New("import file is malformed")
+	ErrImportIncomplete    = errors.New("some users could not be imported")
+
+	ErrAmbiguousBreachChecker = errors.New("either breached passwords or a filter of them may be given")
+	ErrNoBreachFilterFiles    = errors.New("building a breach filter needs a download and a filter file")
+
+	ErrAmbiguousMailer           = errors.New("either an smtp server or a mail outbox may be given")
+	ErrVerificationWithoutMailer = errors.New("verified emails need an smtp server or a mail outbox")
+
+	ErrInvalidTokenPolicy = errors.New("token policy must be given as name:key=value:... " +
+		"with the keys access, refresh, session and sliding")
+)
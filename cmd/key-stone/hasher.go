@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/argon2id"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/bcrypt"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/legacy"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/multi"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/pepper"
+)
+
+const (
+	hasherArgon2id = "argon2id"
+	hasherBcrypt   = "bcrypt"
+)
+
+// newHasher hashes new passwords with the named algorithm and still verifies hashes of the other one
+// and imported legacy hashes.
+// If peppers are given, passwords are peppered with the first one before hashing.
+func newHasher(name string, peppers []string, pepperFiles []string) (hasher.Hasher, error) {
+	argon2idHasher := argon2id.NewHasher(argon2id.DefaultParams())
+	bcryptHasher := bcrypt.NewHasher()
+	legacyHasher := legacy.NewHasher()
+
+	var inner hasher.Hasher
+
+	switch name {
+	case hasherArgon2id:
+		inner = multi.NewHasher(argon2idHasher, bcryptHasher, legacyHasher)
+	case hasherBcrypt:
+		inner = multi.NewHasher(bcryptHasher, argon2idHasher, legacyHasher)
+	default:
+		return nil, fmt.Errorf("password hasher %q: %w", name, ErrUnknownHasher)
+	}
+
+	loaded, err := loadPeppers(peppers, pepperFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(loaded) == 0 {
+		return inner, nil
+	}
+
+	peppered, err := pepper.NewHasher(inner, loaded[0], loaded[1:]...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pepper hasher: %w", err)
+	}
+
+	return peppered, nil
+}
+
+// limitPasswordBytes caps the password policy at the length the named algorithm hashes.
+// Only bcrypt has a limit, and peppered passwords reach it as a short HMAC.
+func limitPasswordBytes(
+	policy *domain.PasswordPolicy,
+	name string,
+	peppers []string,
+	pepperFiles []string,
+) *domain.PasswordPolicy {
+	if name != hasherBcrypt || len(peppers) > 0 || len(pepperFiles) > 0 {
+		return policy
+	}
+
+	return policy.WithMaxBytes(bcrypt.MaxPasswordBytes)
+}
+
+func loadPeppers(peppers []string, pepperFiles []string) ([]pepper.Pepper, error) {
+	peppers = slices.Clone(peppers)
+
+	for _, path := range pepperFiles {
+		content, err := os.ReadFile(path) //nolint:gosec
+		if err != nil {
+			return nil, fmt.Errorf("failed to read pepper file: %w", err)
+		}
+
+		for line := range strings.Lines(string(content)) {
+			line = strings.TrimSpace(line)
+			if line != "" {
+				peppers = append(peppers, line)
+			}
+		}
+	}
+
+	ret := make([]pepper.Pepper, 0, len(peppers))
+
+	for _, value := range peppers {
+		id, key, ok := strings.Cut(value, ":")
+		if !ok || id == "" || key == "" {
+			return nil, fmt.Errorf("pepper %q: %w", id, ErrInvalidPepper)
+		}
+
+		ret = append(ret, pepper.Pepper{
+			ID:  id,
+			Key: []byte(key),
+		})
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/internal/app/flow"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	importHtpasswd = "htpasswd"
+	importLDIF     = "ldif"
+)
+
+// ldifAttributes names the LDAP attributes holding the username and the email of an entry.
+type ldifAttributes struct {
+	username string
+	email    string
+}
+
+// newImportCommand imports users with their password hashes, so they keep logging in with their old passwords.
+// It takes the same configuration as the server.
+func newImportCommand(newConfig func(c *cli.Command) *config) *cli.Command {
+	const (
+		flagFormat            = "format"
+		flagUsernameAttribute = "username-attribute"
+		flagEmailAttribute    = "email-attribute"
+		flagEmailVerified     = "email-verified"
+	)
+
+	return &cli.Command{ //nolint:exhaustruct
+		Name:      "import",
+		Usage:     "Imports users with the password hashes of another system",
+		ArgsUsage: "<file>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name: flagFormat,
+				Usage: "The format of the file, htpasswd for lines of username:hash or ldif for an LDAP export. " +
+					"Hashes may be bcrypt, APR1, {SHA}, {SSHA} or Django PBKDF2",
+				Value: importHtpasswd,
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:  flagUsernameAttribute,
+				Usage: "The LDAP attribute holding the username",
+				Value: "uid",
+			},
+			&cli.StringFlag{ //nolint:exhaustruct
+				Name:  flagEmailAttribute,
+				Usage: "The LDAP attribute holding the email, users without one can log in to set it",
+				Value: "mail",
+			},
+			&cli.BoolFlag{ //nolint:exhaustruct
+				Name:  flagEmailVerified,
+				Usage: "Trusts the imported emails as verified, instead of asking the users to verify them",
+			},
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Args().Len() != 1 {
+				return ErrNoImportFile
+			}
+
+			attributes := ldifAttributes{username: c.String(flagUsernameAttribute), email: c.String(flagEmailAttribute)}
+
+			return importUsers(ctx, newConfig(c), c.String(flagFormat), attributes, c.Bool(flagEmailVerified),
+				c.Args().First())
+		},
+	}
+}
+
+func importUsers(
+	ctx context.Context,
+	cfg *config,
+	format string,
+	attributes ldifAttributes,
+	emailVerified bool,
+	path string,
+) error {
+	file, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	var users []*flow.ImportedUser
+
+	switch format {
+	case importHtpasswd:
+		users, err = readHtpasswd(file)
+	case importLDIF:
+		users, err = readLDIF(file, attributes)
+	default:
+		return fmt.Errorf("import format %q: %w", format, ErrUnknownImportFormat)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+		user.EmailVerified = emailVerified
+	}
+
+	accessKeys, err := loadAccessKeys(cfg)
+	if err != nil {
+		return err
+	}
+
+	refreshKeys, err := loadRefreshKeys(cfg)
+	if err != nil {
+		return err
+	}
+
+	service, closeService, err := newService(ctx, cfg, accessKeys, refreshKeys)
+	if err != nil {
+		return err
+	}
+
+	defer closeService()
+
+	failed := 0
+
+	for _, user := range users {
+		err := service.ImportUser(ctx, user)
+		if err != nil {
+			log.Printf("failed to import %q: %v", user.Username, err)
+
+			failed++
+		}
+	}
+
+	log.Printf("imported %d of %d users", len(users)-failed, len(users))
+
+	if failed > 0 {
+		return fmt.Errorf("%d users: %w", failed, ErrImportIncomplete)
+	}
+
+	return nil
+}
+
+// readHtpasswd reads lines of username:hash, skipping empty lines and comments.
+func readHtpasswd(reader io.Reader) ([]*flow.ImportedUser, error) {
+	var users []*flow.ImportedUser
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		username, hash, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("htpasswd line of %q: %w", username, ErrInvalidImportFile)
+		}
+
+		users = append(users, &flow.ImportedUser{
+			Username:      username,
+			PasswordHash:  hash,
+			Email:         "",
+			EmailVerified: false,
+		})
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read htpasswd file: %w", err)
+	}
+
+	return users, nil
+}
+
+// readLDIF reads the entries of an LDAP export that have both the username attribute and a userPassword.
+func readLDIF(reader io.Reader, attributes ldifAttributes) ([]*flow.ImportedUser, error) {
+	var (
+		users []*flow.ImportedUser
+		lines []string
+	)
+
+	flush := func() error {
+		user, err := parseLDIFEntry(lines, attributes)
+		if err != nil {
+			return err
+		}
+
+		if user != nil {
+			users = append(users, user)
+		}
+
+		lines = nil
+
+		return nil
+	}
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		switch {
+		case line == "":
+			err := flush()
+			if err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, " ") && len(lines) > 0:
+			// a folded line continues the previous one
+			lines[len(lines)-1] += line[1:]
+		default:
+			lines = append(lines, line)
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ldif file: %w", err)
+	}
+
+	err = flush()
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func parseLDIFEntry(lines []string, attributes ldifAttributes) (*flow.ImportedUser, error) {
+	var username, hash, email string
+
+	for _, line := range lines {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("ldif line %q: %w", line, ErrInvalidImportFile)
+		}
+
+		// a value after a double colon is base64 encoded
+		if encoded, ok := strings.CutPrefix(value, ":"); ok {
+			decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+			if err != nil {
+				return nil, fmt.Errorf("ldif attribute %q: %w", name, ErrInvalidImportFile)
+			}
+
+			value = string(decoded)
+		}
+
+		value = strings.TrimSpace(value)
+
+		switch {
+		case strings.EqualFold(name, attributes.username) && username == "":
+			username = value
+		case strings.EqualFold(name, "userPassword") && hash == "":
+			hash = value
+		case strings.EqualFold(name, attributes.email) && email == "":
+			email = value
+		}
+	}
+
+	if username == "" || hash == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &flow.ImportedUser{
+		Username:      username,
+		PasswordHash:  hash,
+		Email:         email,
+		EmailVerified: false,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/neatflowcv/key-stone/pkg/vault"
+)
+
+// keyReader reads the active key and the verify-only keys of a key ring from the configuration.
+type keyReader func(cfg *config) (*vault.Key, []*vault.Key, error)
+
+func loadAccessKeys(cfg *config) (*vault.KeyRing, error) {
+	return loadKeys(cfg, "access", readAccessKeys)
+}
+
+func loadRefreshKeys(cfg *config) (*vault.KeyRing, error) {
+	return loadKeys(cfg, "refresh", readRefreshKeys)
+}
+
+func loadKeys(cfg *config, name string, read keyReader) (*vault.KeyRing, error) {
+	active, verifyOnly, err := read(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ring, err := vault.NewKeyRing(active, verifyOnly...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s key ring: %w", name, err)
+	}
+
+	return ring, nil
+}
+
+// reloadKeysOnHangup re-reads the key files of a ring whenever the process receives SIGHUP,
+// so keys can be rotated without restarting the server. Keys removed from the files keep verifying for grace,
+// which should be the longest lifetime of a token they signed, so those tokens stay valid until they expire.
+func reloadKeysOnHangup(
+	ctx context.Context,
+	ring *vault.KeyRing,
+	cfg *config,
+	name string,
+	read keyReader,
+	grace time.Duration,
+) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+
+	defer signal.Stop(hangup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			active, verifyOnly, err := read(cfg)
+			if err != nil {
+				log.Printf("failed to reload %s keys: %v", name, err)
+
+				continue
+			}
+
+			err = ring.Reload(active, verifyOnly, time.Now().Add(grace))
+			if err != nil {
+				log.Printf("failed to reload %s keys: %v", name, err)
+
+				continue
+			}
+
+			log.Printf("reloaded %s keys, signing with %s", name, active.ID())
+		}
+	}
+}
+
+func readAccessKeys(cfg *config) (*vault.Key, []*vault.Key, error) {
+	if len(cfg.signingKeyFiles) == 0 {
+		if cfg.publicKey == "" {
+			return nil, nil, ErrNoAccessKey
+		}
+
+		return vault.NewHMACKey(cfg.publicKeyID, []byte(cfg.publicKey)), nil, nil
+	}
+
+	keys := make([]*vault.Key, 0, len(cfg.signingKeyFiles))
+
+	for _, signingKeyFile := range cfg.signingKeyFiles {
+		data, err := os.ReadFile(filepath.Clean(signingKeyFile))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read signing key file: %w", err)
+		}
+
+		key, err := vault.ParseKeyPEM(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse signing key file %s: %w", signingKeyFile, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys[0], keys[1:], nil
+}
+
+// readRefreshKeys reads the id:secret refresh keys of the flags and then of the files, the first one signs.
+// Without any the private key signs alone.
+func readRefreshKeys(cfg *config) (*vault.Key, []*vault.Key, error) {
+	values := slices.Clone(cfg.refreshKeys)
+
+	for _, path := range cfg.refreshKeyFiles {
+		content, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read refresh key file: %w", err)
+		}
+
+		for line := range strings.Lines(string(content)) {
+			line = strings.TrimSpace(line)
+			if line != "" {
+				values = append(values, line)
+			}
+		}
+	}
+
+	if len(values) == 0 {
+		if cfg.privateKey == "" {
+			return nil, nil, ErrNoPrivateKey
+		}
+
+		return vault.NewHMACKey(cfg.privateKeyID, []byte(cfg.privateKey)), nil, nil
+	}
+
+	keys := make([]*vault.Key, 0, len(values))
+
+	for _, value := range values {
+		id, secret, ok := strings.Cut(value, ":")
+		if !ok || id == "" || secret == "" {
+			return nil, nil, fmt.Errorf("refresh key %q: %w", id, ErrInvalidRefreshKey)
+		}
+
+		keys = append(keys, vault.NewHMACKey(id, []byte(secret)))
+	}
+
+	return keys[0], keys[1:], nil
+}
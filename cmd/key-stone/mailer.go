@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/mailer"
+	"github.com/neatflowcv/key-stone/internal/pkg/mailer/outbox"
+	"github.com/neatflowcv/key-stone/internal/pkg/mailer/smtp"
+)
+
+// newMailer returns nil if neither an SMTP server nor an outbox is configured.
+func newMailer(cfg *config) (mailer.Mailer, error) {
+	switch {
+	case cfg.smtpAddr != "" && cfg.mailOutbox != "":
+		return nil, ErrAmbiguousMailer
+	case cfg.smtpAddr != "":
+		sender, err := smtp.NewMailer(cfg.smtpAddr, cfg.mailFrom, cfg.smtpUsername, cfg.smtpPassword)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create smtp mailer: %w", err)
+		}
+
+		return sender, nil
+	case cfg.mailOutbox != "":
+		sender, err := outbox.NewMailer(cfg.mailFrom, cfg.mailOutbox)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create outbox mailer: %w", err)
+		}
+
+		return sender, nil
+	default:
+		return nil, nil //nolint:nilnil
+	}
+}
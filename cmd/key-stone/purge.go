@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/app/flow"
+)
+
+// purgeExpiredPeriodically deletes expired entries of the stores at startup and then every interval,
+// until ctx is done. A zero interval never deletes them.
+func purgeExpiredPeriodically(ctx context.Context, service *flow.Service, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := service.PurgeExpired(ctx)
+		if err != nil {
+			log.Printf("failed to purge expired entries: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/neatflowcv/key-stone/internal/pkg/boltdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/bolt"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/file"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/postgres"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/sqlite"
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+	resetbolt "github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/bolt"
+	resetfile "github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/file"
+	resetpostgres "github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/postgres"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+	revocationbolt "github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/bolt"
+	revocationfile "github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/file"
+	revocationpostgres "github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/postgres"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
+	sessionbolt "github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/bolt"
+	sessionfile "github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/file"
+	sessionpostgres "github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/postgres"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository"
+	verificationbolt "github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository/bolt"
+	verificationfile "github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository/file"
+	verificationpostgres "github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository/postgres"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	repositoryFile     = "file"
+	repositorySQLite   = "sqlite"
+	repositoryPostgres = "postgres"
+	repositoryBolt     = "bolt"
+)
+
+// database is shared by the repositories of the postgres or the bolt repository, at most one is set.
+// The file and sqlite repositories keep sessions, revocations and tokens in files.
+type database struct {
+	pool *pgxpool.Pool
+	bolt *bbolt.DB
+}
+
+// newDatabase opens the database shared by the repositories of the kind chosen by the config.
+func newDatabase(ctx context.Context, cfg *config) (*database, error) {
+	switch cfg.repository {
+	case repositoryPostgres:
+		pool, err := postgresdb.Open(ctx, cfg.repositoryDSN)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open repository: %w", err)
+		}
+
+		return &database{pool: pool, bolt: nil}, nil
+	case repositoryBolt:
+		db, err := boltdb.Open(filepath.Join(cfg.repositoryPath, "key-stone.db"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open repository: %w", err)
+		}
+
+		return &database{pool: nil, bolt: db}, nil
+	default:
+		return &database{pool: nil, bolt: nil}, nil
+	}
+}
+
+func (d *database) Close() {
+	if d.pool != nil {
+		d.pool.Close()
+	}
+
+	if d.bolt != nil {
+		closer(d.bolt)()
+	}
+}
+
+// newCredentialRepository creates the credential repository chosen by the config.
+// The returned function releases the repository. The postgres and bolt repositories use db, see newDatabase.
+func newCredentialRepository(
+	ctx context.Context,
+	cfg *config,
+	db *database,
+) (credentialrepository.Repository, func(), error) {
+	path := cfg.repositoryPath
+
+	switch cfg.repository {
+	case repositoryFile:
+		repository, err := file.NewRepository(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create repository: %w", err)
+		}
+
+		return repository, func() {}, nil
+	case repositorySQLite:
+		repository, err := sqlite.NewRepository(ctx, filepath.Join(path, "credentials.db"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create repository: %w", err)
+		}
+
+		return repository, closer(repository), nil
+	case repositoryPostgres:
+		repository, err := postgres.NewRepository(ctx, db.pool)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create repository: %w", err)
+		}
+
+		return repository, func() {}, nil
+	case repositoryBolt:
+		repository, err := bolt.NewRepository(db.bolt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create repository: %w", err)
+		}
+
+		return repository, func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("repository %q: %w", cfg.repository, ErrUnknownRepository)
+	}
+}
+
+// newSessionRepository keeps the sessions in db, in files under --session-repository-path without one.
+func newSessionRepository(
+	ctx context.Context,
+	cfg *config,
+	db *database,
+) (sessionrepository.Repository, error) {
+	switch {
+	case db.pool != nil:
+		repository, err := sessionpostgres.NewRepository(ctx, db.pool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create session repository: %w", err)
+		}
+
+		return repository, nil
+	case db.bolt != nil:
+		repository, err := sessionbolt.NewRepository(db.bolt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create session repository: %w", err)
+		}
+
+		return repository, nil
+	}
+
+	repository, err := sessionfile.NewRepository(cfg.sessionPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session repository: %w", err)
+	}
+
+	return repository, nil
+}
+
+// newRevocationRepository keeps the revocations in db, in files under --revocation-repository-path without one.
+func newRevocationRepository(
+	ctx context.Context,
+	cfg *config,
+	db *database,
+) (revocationrepository.Repository, error) {
+	switch {
+	case db.pool != nil:
+		repository, err := revocationpostgres.NewRepository(ctx, db.pool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create revocation repository: %w", err)
+		}
+
+		return repository, nil
+	case db.bolt != nil:
+		repository, err := revocationbolt.NewRepository(db.bolt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create revocation repository: %w", err)
+		}
+
+		return repository, nil
+	}
+
+	repository, err := revocationfile.NewRepository(cfg.revocationPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create revocation repository: %w", err)
+	}
+
+	return repository, nil
+}
+
+// newResetTokenRepository keeps the reset tokens in db, in files under --reset-token-repository-path without one.
+func newResetTokenRepository(
+	ctx context.Context,
+	cfg *config,
+	db *database,
+) (resettokenrepository.Repository, error) {
+	switch {
+	case db.pool != nil:
+		repository, err := resetpostgres.NewRepository(ctx, db.pool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create reset token repository: %w", err)
+		}
+
+		return repository, nil
+	case db.bolt != nil:
+		repository, err := resetbolt.NewRepository(db.bolt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create reset token repository: %w", err)
+		}
+
+		return repository, nil
+	}
+
+	repository, err := resetfile.NewRepository(cfg.resetPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reset token repository: %w", err)
+	}
+
+	return repository, nil
+}
+
+// newVerificationTokenRepository keeps the verification tokens in db, in files under
+// --verification-token-repository-path without one.
+func newVerificationTokenRepository(
+	ctx context.Context,
+	cfg *config,
+	db *database,
+) (verificationtokenrepository.Repository, error) {
+	switch {
+	case db.pool != nil:
+		repository, err := verificationpostgres.NewRepository(ctx, db.pool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create verification token repository: %w", err)
+		}
+
+		return repository, nil
+	case db.bolt != nil:
+		repository, err := verificationbolt.NewRepository(db.bolt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create verification token repository: %w", err)
+		}
+
+		return repository, nil
+	}
+
+	repository, err := verificationfile.NewRepository(cfg.verifyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create verification token repository: %w", err)
+	}
+
+	return repository, nil
+}
+
+func closer(repository interface{ Close() error }) func() {
+	return func() {
+		err := repository.Close()
+		if err != nil {
+			log.Printf("failed to close repository: %v", err)
+		}
+	}
+}
@@ -3,12 +3,17 @@ package main
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/neatflowcv/key-stone/gen/token"
 	"github.com/neatflowcv/key-stone/internal/app/flow"
+	"goa.design/goa/v3/security"
 )
 
-var _ token.Service = (*TokenHandler)(nil)
+var (
+	_ token.Service = (*TokenHandler)(nil)
+	_ token.Auther  = (*TokenHandler)(nil)
+)
 
 type TokenHandler struct {
 	service *flow.Service
@@ -23,16 +28,47 @@ func NewTokenHandler(
 }
 
 func (h *TokenHandler) Issue(ctx context.Context, payload *token.IssueInput) (*token.TokenDetail, error) {
+	var client *flow.Client
+	if payload.ClientID != nil {
+		var secret string
+		if payload.ClientSecret != nil {
+			secret = *payload.ClientSecret
+		}
+
+		client = &flow.Client{
+			ID:     *payload.ClientID,
+			Secret: secret,
+		}
+	}
+
+	var scope []string
+	if payload.Scope != nil {
+		scope = strings.Fields(*payload.Scope)
+	}
+
 	tokenSet, err := h.service.CreateToken(ctx, &flow.Credential{
 		Username: payload.Username,
 		Password: payload.Password,
+	}, client, &flow.TokenRequest{
+		Audience: payload.Audience,
+		Scope:    scope,
 	})
 	if err != nil {
 		switch {
+		case errors.Is(err, flow.ErrAudienceNotAllowed):
+			return nil, token.MakeBadRequest(err)
+		case errors.Is(err, flow.ErrClientUnauthorized):
+			return nil, token.MakeUnauthorized(err)
 		case errors.Is(err, flow.ErrUserNotFound):
 			return nil, token.MakeUnauthorized(err)
 		case errors.Is(err, flow.ErrUserUnauthorized):
 			return nil, token.MakeUnauthorized(err)
+		case errors.Is(err, flow.ErrEmailNotVerified):
+			return nil, token.MakeUnauthorized(err)
+		case errors.Is(err, flow.ErrUserLocked):
+			return nil, token.MakeUnauthorized(err)
+		case errors.Is(err, flow.ErrUserDisabled):
+			return nil, token.MakeUnauthorized(err)
 		default:
 			return nil, token.MakeInternalServerError(err)
 		}
@@ -40,21 +76,31 @@ func (h *TokenHandler) Issue(ctx context.Context, payload *token.IssueInput) (*t
 
 	return &token.TokenDetail{
 		AccessToken:  tokenSet.AccessToken,
-		TokenType:    "Bearer",
+		TokenType:    flow.TokenTypeBearer,
 		ExpiresIn:    tokenSet.ExpiresIn,
 		RefreshToken: tokenSet.RefreshToken,
+		Scope:        optional(strings.Join(tokenSet.Scope, " ")),
 	}, nil
 }
 
 func (h *TokenHandler) Refresh(ctx context.Context, payload *token.RefreshInput) (*token.TokenDetail, error) {
+	var accessToken string
+	if payload.AccessToken != nil {
+		accessToken = *payload.AccessToken
+	}
+
 	tokenSet, err := h.service.RefreshToken(ctx, &flow.TokenSetInput{
-		AccessToken:  payload.AccessToken,
+		AccessToken:  accessToken,
 		RefreshToken: payload.RefreshToken,
 	})
 	if err != nil {
 		switch {
 		case errors.Is(err, flow.ErrTokenInvalid):
 			return nil, token.MakeUnauthorized(err)
+		case errors.Is(err, flow.ErrTokenReused):
+			return nil, token.MakeUnauthorized(err)
+		case errors.Is(err, flow.ErrTokenMismatched):
+			return nil, token.MakeUnauthorized(err)
 		case errors.Is(err, flow.ErrUserNotFound):
 			return nil, token.MakeUnauthorized(err)
 		default:
@@ -64,8 +110,85 @@ func (h *TokenHandler) Refresh(ctx context.Context, payload *token.RefreshInput)
 
 	return &token.TokenDetail{
 		AccessToken:  tokenSet.AccessToken,
-		TokenType:    "Bearer",
+		TokenType:    flow.TokenTypeBearer,
 		ExpiresIn:    tokenSet.ExpiresIn,
 		RefreshToken: tokenSet.RefreshToken,
+		Scope:        optional(strings.Join(tokenSet.Scope, " ")),
+	}, nil
+}
+
+func (h *TokenHandler) Revoke(ctx context.Context, payload *token.RevokeInput) error {
+	var hint flow.TokenTypeHint
+	if payload.TokenTypeHint != nil {
+		hint = flow.TokenTypeHint(*payload.TokenTypeHint)
+	}
+
+	err := h.service.RevokeToken(ctx, payload.ClientID, payload.Token, hint)
+	if err != nil {
+		switch {
+		case errors.Is(err, flow.ErrClientUnauthorized):
+			return token.MakeUnauthorized(err)
+		default:
+			return token.MakeInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+func (h *TokenHandler) BasicAuth(
+	ctx context.Context,
+	user, pass string,
+	schema *security.BasicScheme,
+) (context.Context, error) {
+	err := h.service.AuthenticateClient(ctx, &flow.Client{
+		ID:     user,
+		Secret: pass,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, flow.ErrClientUnauthorized):
+			return ctx, token.MakeUnauthorized(err)
+		default:
+			return ctx, token.MakeInternalServerError(err)
+		}
+	}
+
+	return ctx, nil
+}
+
+func (h *TokenHandler) Introspect(
+	ctx context.Context,
+	payload *token.IntrospectInput,
+) (*token.IntrospectionResult, error) {
+	var hint flow.TokenTypeHint
+	if payload.TokenTypeHint != nil {
+		hint = flow.TokenTypeHint(*payload.TokenTypeHint)
+	}
+
+	introspection, err := h.service.IntrospectToken(ctx, payload.Token, hint)
+	if err != nil {
+		return nil, token.MakeInternalServerError(err)
+	}
+
+	if !introspection.Active {
+		return &token.IntrospectionResult{Active: false}, nil //nolint:exhaustruct
+	}
+
+	issuedAt := introspection.IssuedAt.Unix()
+	expiresAt := introspection.ExpiresAt.Unix()
+
+	return &token.IntrospectionResult{
+		Active:    true,
+		TokenType: optional(introspection.TokenType),
+		Jti:       optional(introspection.TokenID),
+		Sub:       optional(introspection.Subject),
+		Iss:       optional(introspection.Issuer),
+		Aud:       introspection.Audience,
+		Scope:     optional(introspection.Scope),
+		ClientID:  optional(introspection.ClientID),
+		Iat:       &issuedAt,
+		Exp:       &expiresAt,
+		Ext:       introspection.Claims,
 	}, nil
 }
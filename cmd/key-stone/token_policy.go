@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/app/flow"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+// tokenPolicyOptions returns the default token policy and its overrides per client and per role.
+// An override is given as name:key=value:... and starts from the default policy, e.g.
+// "mobile:access=5m:refresh=720h:session=2160h:sliding=false".
+func tokenPolicyOptions(policy *domain.TokenPolicy, clientPolicies, rolePolicies []string) ([]flow.Option, error) {
+	opts := []flow.Option{flow.WithTokenPolicy(policy)}
+
+	for _, override := range clientPolicies {
+		clientID, clientPolicy, err := parseTokenPolicy(policy, override)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, flow.WithClientTokenPolicy(clientID, clientPolicy))
+	}
+
+	for _, override := range rolePolicies {
+		role, rolePolicy, err := parseTokenPolicy(policy, override)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, flow.WithRoleTokenPolicy(role, rolePolicy))
+	}
+
+	return opts, nil
+}
+
+func parseTokenPolicy(base *domain.TokenPolicy, override string) (string, *domain.TokenPolicy, error) {
+	name, settings, ok := strings.Cut(override, ":")
+	if !ok || name == "" || settings == "" {
+		return "", nil, fmt.Errorf("token policy %q: %w", override, ErrInvalidTokenPolicy)
+	}
+
+	policy := base
+
+	for setting := range strings.SplitSeq(settings, ":") {
+		key, value, ok := strings.Cut(setting, "=")
+		if !ok {
+			return "", nil, fmt.Errorf("token policy %q: %w", override, ErrInvalidTokenPolicy)
+		}
+
+		var err error
+
+		policy, err = applyTokenPolicySetting(policy, key, value)
+		if err != nil {
+			return "", nil, fmt.Errorf("token policy %q: %w", override, err)
+		}
+	}
+
+	return name, policy, nil
+}
+
+func applyTokenPolicySetting(policy *domain.TokenPolicy, key, value string) (*domain.TokenPolicy, error) {
+	if key == "sliding" {
+		sliding, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", key, err)
+		}
+
+		return policy.WithSlidingRefresh(sliding), nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", key, err)
+	}
+
+	switch key {
+	case "access":
+		return policy.WithAccessTokenDuration(duration), nil
+	case "refresh":
+		return policy.WithRefreshTokenDuration(duration), nil
+	case "session":
+		return policy.WithSessionLifetime(duration), nil
+	default:
+		return nil, fmt.Errorf("unknown setting %q: %w", key, ErrInvalidTokenPolicy)
+	}
+}
+
+// longestTokenDuration returns the longest lifetime of a token under the default policy and its overrides,
+// e.g. of an access token with (*domain.TokenPolicy).AccessTokenDuration.
+func longestTokenDuration(
+	policy *domain.TokenPolicy,
+	clientPolicies []string,
+	rolePolicies []string,
+	duration func(policy *domain.TokenPolicy) time.Duration,
+) (time.Duration, error) {
+	longest := duration(policy)
+
+	for _, override := range slices.Concat(clientPolicies, rolePolicies) {
+		_, overridePolicy, err := parseTokenPolicy(policy, override)
+		if err != nil {
+			return 0, err
+		}
+
+		longest = max(longest, duration(overridePolicy))
+	}
+
+	return longest, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/neatflowcv/key-stone/gen/user"
 	"github.com/neatflowcv/key-stone/internal/app/flow"
@@ -24,12 +25,22 @@ func NewUserHandler(
 }
 
 func (h *UserHandler) Create(ctx context.Context, payload *user.UserInput) error {
+	var email string
+	if payload.Email != nil {
+		email = *payload.Email
+	}
+
 	err := h.service.CreateUser(ctx, &flow.Credential{
 		Username: payload.Username,
 		Password: payload.Password,
+		Email:    email,
 	})
 	if err != nil {
 		switch {
+		case errors.Is(err, flow.ErrUsernameInvalid),
+			errors.Is(err, flow.ErrPasswordInvalid),
+			errors.Is(err, flow.ErrEmailInvalid):
+			return user.MakeBadRequest(err)
 		case errors.Is(err, flow.ErrUserAlreadyExists):
 			return user.MakeUserAlreadyExists(err)
 		default:
@@ -56,3 +67,160 @@ func (h *UserHandler) Delete(ctx context.Context, payload *user.DeleteUserPayloa
 
 	return nil
 }
+
+func (h *UserHandler) ChangePassword(ctx context.Context, payload *user.ChangePasswordPayload) error {
+	err := h.service.ChangePassword(ctx, &flow.PasswordChange{
+		Token:           strings.TrimPrefix(payload.Authorization, "Bearer "),
+		CurrentPassword: payload.CurrentPassword,
+		NewPassword:     payload.NewPassword,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, flow.ErrPasswordInvalid):
+			return user.MakeBadRequest(err)
+		case errors.Is(err, flow.ErrTokenInvalid),
+			errors.Is(err, flow.ErrUserNotFound),
+			errors.Is(err, flow.ErrUserUnauthorized):
+			return user.MakeUnauthorized(err)
+		default:
+			return user.MakeInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, payload *user.PasswordResetRequest) error {
+	err := h.service.RequestPasswordReset(ctx, payload.Username)
+	if err != nil {
+		switch {
+		case errors.Is(err, flow.ErrNotImplemented):
+			return user.MakeNotImplemented(err)
+		default:
+			return user.MakeInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+func (h *UserHandler) ResetPassword(ctx context.Context, payload *user.PasswordResetInput) error {
+	err := h.service.ResetPassword(ctx, &flow.PasswordReset{
+		Token:       payload.Token,
+		NewPassword: payload.NewPassword,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, flow.ErrPasswordInvalid):
+			return user.MakeBadRequest(err)
+		case errors.Is(err, flow.ErrTokenInvalid):
+			return user.MakeUnauthorized(err)
+		case errors.Is(err, flow.ErrNotImplemented):
+			return user.MakeNotImplemented(err)
+		default:
+			return user.MakeInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+func (h *UserHandler) RequestEmailVerification(ctx context.Context, payload *user.EmailVerificationRequest) error {
+	err := h.service.RequestEmailVerification(ctx, payload.Username)
+	if err != nil {
+		switch {
+		case errors.Is(err, flow.ErrNotImplemented):
+			return user.MakeNotImplemented(err)
+		default:
+			return user.MakeInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+func (h *UserHandler) VerifyEmail(ctx context.Context, payload *user.EmailVerificationInput) error {
+	err := h.service.VerifyEmail(ctx, payload.Token)
+	if err != nil {
+		switch {
+		case errors.Is(err, flow.ErrTokenInvalid):
+			return user.MakeUnauthorized(err)
+		case errors.Is(err, flow.ErrNotImplemented):
+			return user.MakeNotImplemented(err)
+		default:
+			return user.MakeInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+func (h *UserHandler) GetMe(ctx context.Context, payload *user.GetUserPayload) (*user.UserProfile, error) {
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+
+	profile, err := h.service.GetUser(ctx, token)
+	if err != nil {
+		switch {
+		case errors.Is(err, flow.ErrTokenInvalid),
+			errors.Is(err, flow.ErrUserNotFound):
+			return nil, user.MakeUnauthorized(err)
+		default:
+			return nil, user.MakeInternalServerError(err)
+		}
+	}
+
+	return userProfile(profile), nil
+}
+
+func (h *UserHandler) UpdateMe(ctx context.Context, payload *user.UpdateUserPayload) (*user.UserProfile, error) {
+	token := strings.TrimPrefix(payload.Authorization, "Bearer ")
+
+	profile, err := h.service.UpdateUser(ctx, &flow.UserUpdate{
+		Token:       token,
+		DisplayName: payload.DisplayName,
+		Locale:      payload.Locale,
+		Attributes:  payload.Attributes,
+		Email:       payload.Email,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, flow.ErrProfileInvalid),
+			errors.Is(err, flow.ErrEmailInvalid):
+			return nil, user.MakeBadRequest(err)
+		case errors.Is(err, flow.ErrTokenInvalid),
+			errors.Is(err, flow.ErrUserNotFound):
+			return nil, user.MakeUnauthorized(err)
+		default:
+			return nil, user.MakeInternalServerError(err)
+		}
+	}
+
+	return userProfile(profile), nil
+}
+
+func userProfile(profile *flow.User) *user.UserProfile {
+	attributes := profile.Attributes
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	return &user.UserProfile{
+		Username:      profile.Username,
+		Email:         optional(profile.Email),
+		EmailVerified: profile.EmailVerified,
+		DisplayName:   optional(profile.DisplayName),
+		Locale:        optional(profile.Locale),
+		Attributes:    attributes,
+		CreatedAt:     optionalTime(profile.CreatedAt),
+		UpdatedAt:     optionalTime(profile.UpdatedAt),
+	}
+}
+
+// optionalTime formats t as RFC 3339, nil if t is zero.
+func optionalTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+
+	return optional(t.UTC().Format(time.RFC3339))
+}
@@ -14,14 +14,20 @@ var _ = API("key-stone", func() {
 	})
 })
 
+var ClientAuth = BasicAuthSecurity("client_auth", func() { //nolint:gochecknoglobals
+	Description("The credentials of a registered client")
+})
+
 var _ = Service("user", func() {
 	HTTP(func() {
 		Path("/users")
 	})
 
+	Error("BadRequest", ErrorResult, "Bad Request")
 	Error("Unauthorized", ErrorResult, "Unauthorized")
 	Error("UserAlreadyExists", ErrorResult, "User Already Exists")
 	Error("InternalServerError", ErrorResult, "Internal Server Error")
+	Error("NotImplemented", ErrorResult, "Not Implemented")
 
 	Method("create", func() {
 		Payload(UserInput)
@@ -30,6 +36,7 @@ var _ = Service("user", func() {
 			POST("/")
 
 			Response(StatusNoContent)
+			Response("BadRequest", StatusBadRequest)
 			Response("UserAlreadyExists", StatusUnauthorized)
 			Response("InternalServerError", StatusInternalServerError)
 		})
@@ -47,6 +54,96 @@ var _ = Service("user", func() {
 			Response("InternalServerError", StatusInternalServerError)
 		})
 	})
+	Method("get_me", func() {
+		Payload(GetUserPayload)
+		Result(UserProfile)
+
+		HTTP(func() {
+			GET("/me")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusOK)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("update_me", func() {
+		Payload(UpdateUserPayload)
+		Result(UserProfile)
+
+		HTTP(func() {
+			PATCH("/me")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("change_password", func() {
+		Payload(ChangePasswordPayload)
+
+		HTTP(func() {
+			PUT("/me/password")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusNoContent)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("request_password_reset", func() {
+		Payload(PasswordResetRequest)
+
+		HTTP(func() {
+			POST("/password-reset")
+
+			Response(StatusAccepted)
+			Response("NotImplemented", StatusNotImplemented)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("reset_password", func() {
+		Payload(PasswordResetInput)
+
+		HTTP(func() {
+			PUT("/password-reset")
+
+			Response(StatusNoContent)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("NotImplemented", StatusNotImplemented)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("request_email_verification", func() {
+		Payload(EmailVerificationRequest)
+
+		HTTP(func() {
+			POST("/email-verification")
+
+			Response(StatusAccepted)
+			Response("NotImplemented", StatusNotImplemented)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("verify_email", func() {
+		Payload(EmailVerificationInput)
+
+		HTTP(func() {
+			PUT("/email-verification")
+
+			Response(StatusNoContent)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("NotImplemented", StatusNotImplemented)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
 })
 
 var _ = Service("token", func() {
@@ -54,6 +151,7 @@ var _ = Service("token", func() {
 		Path("/auth")
 	})
 
+	Error("BadRequest", ErrorResult, "Bad Request")
 	Error("Unauthorized", ErrorResult, "Unauthorized")
 	Error("InternalServerError", ErrorResult, "Internal Server Error")
 
@@ -65,6 +163,7 @@ var _ = Service("token", func() {
 			POST("/")
 
 			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
 			Response("Unauthorized", StatusUnauthorized)
 		})
 	})
@@ -81,11 +180,204 @@ var _ = Service("token", func() {
 			Response("InternalServerError", StatusInternalServerError)
 		})
 	})
+
+	Method("revoke", func() {
+		Security(ClientAuth)
+
+		Payload(RevokeInput)
+
+		HTTP(func() {
+			POST("/revoke")
+
+			Response(StatusOK)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+
+	Method("introspect", func() {
+		Security(ClientAuth)
+
+		Payload(IntrospectInput)
+		Result(IntrospectionResult)
+
+		HTTP(func() {
+			POST("/introspect")
+
+			Response(StatusOK)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+})
+
+var _ = Service("admin", func() {
+	HTTP(func() {
+		Path("/admin/users")
+	})
+
+	Error("BadRequest", ErrorResult, "Bad Request")
+	Error("Unauthorized", ErrorResult, "Unauthorized")
+	Error("Forbidden", ErrorResult, "Forbidden")
+	Error("NotFound", ErrorResult, "Not Found")
+	Error("InternalServerError", ErrorResult, "Internal Server Error")
+	Error("NotImplemented", ErrorResult, "Not Implemented")
+
+	Method("list", func() {
+		Payload(ListUsersPayload)
+		Result(UserList)
+
+		HTTP(func() {
+			GET("/")
+
+			Header("Authorization", String, "The authorization header")
+			Param("after")
+			Param("search")
+			Param("limit")
+
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("get", func() {
+		Payload(AdminUserPayload)
+		Result(AdminUser)
+
+		HTTP(func() {
+			GET("/{username}")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusOK)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("delete", func() {
+		Payload(AdminUserPayload)
+
+		HTTP(func() {
+			DELETE("/{username}")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusNoContent)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("disable", func() {
+		Payload(AdminUserPayload)
+
+		HTTP(func() {
+			POST("/{username}/disable")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusNoContent)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("enable", func() {
+		Payload(AdminUserPayload)
+
+		HTTP(func() {
+			POST("/{username}/enable")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusNoContent)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("reset_password", func() {
+		Payload(AdminUserPayload)
+
+		HTTP(func() {
+			POST("/{username}/password-reset")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusAccepted)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("NotImplemented", StatusNotImplemented)
+		})
+	})
+	Method("unlock", func() {
+		Payload(AdminUserPayload)
+
+		HTTP(func() {
+			POST("/{username}/unlock")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusNoContent)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+	Method("set_email", func() {
+		Payload(AdminEmailPayload)
+
+		HTTP(func() {
+			PUT("/{username}/email")
+
+			Header("Authorization", String, "The authorization header")
+
+			Response(StatusNoContent)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+})
+
+var _ = Service("discovery", func() {
+	HTTP(func() {
+		Path("/.well-known")
+	})
+
+	Method("jwks", func() {
+		Result(JSONWebKeySet)
+
+		HTTP(func() {
+			GET("/jwks.json")
+
+			Response(StatusOK)
+		})
+	})
 })
 
 var UserInput = Type("UserInput", func() { //nolint:gochecknoglobals
-	Attribute("username", String, "The name of the user")
+	Attribute("username", String, "The name of the user, compared case-insensitively", func() {
+		// the username policy validates the normalized name, a pattern here would judge the raw one
+		MaxLength(256) //nolint:mnd
+	})
 	Attribute("password", String, "The password of the user")
+	Attribute("email", String, "The address password resets and verification tokens are sent to", func() {
+		Format(FormatEmail)
+	})
 
 	Required("username", "password")
 })
@@ -96,9 +388,154 @@ var DeleteUserPayload = Type("DeleteUserPayload", func() { //nolint:gochecknoglo
 	Required("Authorization")
 })
 
+var GetUserPayload = Type("GetUserPayload", func() { //nolint:gochecknoglobals
+	Attribute("Authorization", String, "The payload of the user")
+
+	Required("Authorization")
+})
+
+var UpdateUserPayload = Type("UpdateUserPayload", func() { //nolint:gochecknoglobals
+	Attribute("Authorization", String, "The payload of the user")
+	Attribute("display_name", String, "The name the user is shown as, unchanged if omitted", func() {
+		MaxLength(128) //nolint:mnd
+	})
+	Attribute("locale", String, "The BCP 47 language tag the user prefers, unchanged if omitted", func() {
+		MaxLength(35) //nolint:mnd
+	})
+	Attribute("attributes", MapOf(String, String, func() {
+		Key(func() {
+			MinLength(1)
+			MaxLength(64) //nolint:mnd
+		})
+		Elem(func() {
+			MaxLength(1024) //nolint:mnd
+		})
+	}), "The key/value pairs of the applications, replacing every attribute, unchanged if omitted", func() {
+		MaxLength(32) //nolint:mnd
+	})
+	Attribute("email", String, "A new email, unverified until the user verifies it, unchanged if omitted", func() {
+		Format(FormatEmail)
+	})
+
+	Required("Authorization")
+})
+
+var UserProfile = Type("UserProfile", func() { //nolint:gochecknoglobals
+	Attribute("username", String, "The name of the user")
+	Attribute("email", String, "The email of the user")
+	Attribute("email_verified", Boolean, "Whether the user verified the email")
+	Attribute("display_name", String, "The name the user is shown as")
+	Attribute("locale", String, "The BCP 47 language tag the user prefers")
+	Attribute("attributes", MapOf(String, String), "The key/value pairs of the applications")
+	Attribute("created_at", String, "The time the user was created, unknown for users of older versions", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, "The time the user or an admin last changed the profile or the password", func() {
+		Format(FormatDateTime)
+	})
+
+	Required("username", "email_verified", "attributes")
+})
+
+var ListUsersPayload = Type("ListUsersPayload", func() { //nolint:gochecknoglobals
+	Attribute("Authorization", String, "The payload of the admin")
+	Attribute("after", String, "The next of the previous page, omitted for the first page")
+	Attribute("search", String, "Only users whose username or email contains it, case-sensitively")
+	Attribute("limit", Int, "The most users on a page", func() {
+		Default(50) //nolint:mnd
+		Minimum(1)
+		Maximum(500) //nolint:mnd
+	})
+
+	Required("Authorization")
+})
+
+var AdminUserPayload = Type("AdminUserPayload", func() { //nolint:gochecknoglobals
+	Attribute("Authorization", String, "The payload of the admin")
+	Attribute("username", String, "The name of the user")
+
+	Required("Authorization", "username")
+})
+
+var AdminEmailPayload = Type("AdminEmailPayload", func() { //nolint:gochecknoglobals
+	Attribute("Authorization", String, "The payload of the admin")
+	Attribute("username", String, "The name of the user")
+	Attribute("email", String, "The new email of the user", func() {
+		Format(FormatEmail)
+	})
+	Attribute("verified", Boolean, "Whether the admin vouches for the email, else it is mailed a verification token",
+		func() {
+			Default(false)
+		})
+
+	Required("Authorization", "username", "email")
+})
+
+var AdminUser = Type("AdminUser", func() { //nolint:gochecknoglobals
+	Extend(UserProfile)
+
+	Attribute("disabled", Boolean, "Whether the user is disabled")
+	Attribute("locked_until", String, "The time a lockout after too many failed logins ends, if the user is locked",
+		func() {
+			Format(FormatDateTime)
+		})
+
+	Required("disabled")
+})
+
+var UserList = Type("UserList", func() { //nolint:gochecknoglobals
+	Attribute("users", ArrayOf(AdminUser), "The users of the page, ordered by username")
+	Attribute("next", String, "The after of the next page, omitted on the last page")
+
+	Required("users")
+})
+
+var ChangePasswordPayload = Type("ChangePasswordPayload", func() { //nolint:gochecknoglobals
+	Attribute("Authorization", String, "The payload of the user")
+	Attribute("current_password", String, "The password the user has now")
+	Attribute("new_password", String, "The password replacing it, checked against the password policy")
+
+	Required("Authorization", "current_password", "new_password")
+})
+
+var PasswordResetRequest = Type("PasswordResetRequest", func() { //nolint:gochecknoglobals
+	Attribute("username", String, "The user who forgot the password", func() {
+		MaxLength(256) //nolint:mnd
+	})
+
+	Required("username")
+})
+
+var PasswordResetInput = Type("PasswordResetInput", func() { //nolint:gochecknoglobals
+	Attribute("token", String, "The reset token mailed to the user")
+	Attribute("new_password", String, "The new password, checked against the password policy")
+
+	Required("token", "new_password")
+})
+
+var EmailVerificationRequest = Type("EmailVerificationRequest", func() { //nolint:gochecknoglobals
+	Attribute("username", String, "The user whose email is not verified yet", func() {
+		MaxLength(256) //nolint:mnd
+	})
+
+	Required("username")
+})
+
+var EmailVerificationInput = Type("EmailVerificationInput", func() { //nolint:gochecknoglobals
+	Attribute("token", String, "The verification token mailed to the user")
+
+	Required("token")
+})
+
 var IssueInput = Type("IssueInput", func() { //nolint:gochecknoglobals
-	Attribute("username", String, "The username of the user")
+	Attribute("username", String, "The username of the user", func() {
+		MaxLength(256) //nolint:mnd
+	})
 	Attribute("password", String, "The password of the user")
+	Attribute("client_id", String, "The client the user logs in with, which may have its own token policy")
+	Attribute("client_secret", String, "The secret of the client")
+	Attribute("audience", ArrayOf(String), "The services the access token is meant for, key-stone itself if omitted")
+	Attribute("scope", String, "The space separated scopes requested, every granted scope if omitted")
 
 	Required("username", "password")
 })
@@ -108,13 +545,72 @@ var TokenDetail = Type("TokenDetail", func() { //nolint:gochecknoglobals
 	Attribute("token_type", String, "The token type of the user")
 	Attribute("expires_in", Int, "The expires in of the user")
 	Attribute("refresh_token", String, "The refresh token of the user")
+	Attribute("scope", String, "The space separated scopes granted to the access token")
 
 	Required("access_token", "token_type", "expires_in", "refresh_token")
 })
 
 var RefreshInput = Type("RefreshInput", func() { //nolint:gochecknoglobals
-	Attribute("access_token", String, "The access token of the user")
+	Attribute("access_token", String, "The access token of the same session, may be expired")
 	Attribute("refresh_token", String, "The refresh token of the user")
 
-	Required("access_token", "refresh_token")
+	Required("refresh_token")
+})
+
+var RevokeInput = Type("RevokeInput", func() { //nolint:gochecknoglobals
+	Username("client_id", String, "The ID of the client")
+	Password("client_secret", String, "The secret of the client")
+	Attribute("token", String, "The access or refresh token to revoke")
+	Attribute("token_type_hint", String, "The kind of the token", func() {
+		Enum("access_token", "refresh_token")
+	})
+
+	Required("client_id", "client_secret", "token")
+})
+
+var IntrospectInput = Type("IntrospectInput", func() { //nolint:gochecknoglobals
+	Username("client_id", String, "The ID of the client")
+	Password("client_secret", String, "The secret of the client")
+	Attribute("token", String, "The access or refresh token to introspect")
+	Attribute("token_type_hint", String, "The kind of the token", func() {
+		Enum("access_token", "refresh_token")
+	})
+
+	Required("client_id", "client_secret", "token")
+})
+
+var IntrospectionResult = Type("IntrospectionResult", func() { //nolint:gochecknoglobals
+	Attribute("active", Boolean, "Whether the token is currently active")
+	Attribute("token_type", String, "The type of an access token, Bearer. Omitted for refresh tokens")
+	Attribute("jti", String, "The ID of the token")
+	Attribute("sub", String, "The subject of the token")
+	Attribute("iss", String, "The issuer of the token")
+	Attribute("aud", ArrayOf(String), "The audience of the token")
+	Attribute("scope", String, "The space separated scopes of the token")
+	Attribute("client_id", String, "The client the token was issued to")
+	Attribute("iat", Int64, "The time the token was issued at, in seconds since the epoch")
+	Attribute("exp", Int64, "The time the token expires at, in seconds since the epoch")
+	Attribute("ext", MapOf(String, Any), "The custom claims of the token")
+
+	Required("active")
+})
+
+var JSONWebKey = Type("JSONWebKey", func() { //nolint:gochecknoglobals
+	Attribute("kty", String, "The key type")
+	Attribute("use", String, "The intended use of the key")
+	Attribute("alg", String, "The algorithm the key is used with")
+	Attribute("kid", String, "The key ID")
+	Attribute("n", String, "The RSA modulus")
+	Attribute("e", String, "The RSA public exponent")
+	Attribute("crv", String, "The curve of the EC or OKP key")
+	Attribute("x", String, "The x coordinate of the EC key or the OKP public key")
+	Attribute("y", String, "The y coordinate of the EC key")
+
+	Required("kty")
+})
+
+var JSONWebKeySet = Type("JSONWebKeySet", func() { //nolint:gochecknoglobals
+	Attribute("keys", ArrayOf(JSONWebKey), "The keys that verify access tokens")
+
+	Required("keys")
 })
@@ -0,0 +1,160 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// admin client
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package admin
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "admin" service client.
+type Client struct {
+	ListEndpoint          goa.Endpoint
+	GetEndpoint           goa.Endpoint
+	DeleteEndpoint        goa.Endpoint
+	DisableEndpoint       goa.Endpoint
+	EnableEndpoint        goa.Endpoint
+	ResetPasswordEndpoint goa.Endpoint
+	UnlockEndpoint        goa.Endpoint
+	SetEmailEndpoint      goa.Endpoint
+}
+
+// NewClient initializes a "admin" service client given the endpoints.
+func NewClient(list, get, delete_, disable, enable, resetPassword, unlock, setEmail goa.Endpoint) *Client {
+	return &Client{
+		ListEndpoint:          list,
+		GetEndpoint:           get,
+		DeleteEndpoint:        delete_,
+		DisableEndpoint:       disable,
+		EnableEndpoint:        enable,
+		ResetPasswordEndpoint: resetPassword,
+		UnlockEndpoint:        unlock,
+		SetEmailEndpoint:      setEmail,
+	}
+}
+
+// List calls the "list" endpoint of the "admin" service.
+// List may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Forbidden
+//   - "NotFound" (type *goa.ServiceError): Not Found
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) List(ctx context.Context, p *ListUsersPayload) (res *UserList, err error) {
+	var ires any
+	ires, err = c.ListEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UserList), nil
+}
+
+// Get calls the "get" endpoint of the "admin" service.
+// Get may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Forbidden
+//   - "NotFound" (type *goa.ServiceError): Not Found
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) Get(ctx context.Context, p *AdminUserPayload) (res *AdminUser, err error) {
+	var ires any
+	ires, err = c.GetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AdminUser), nil
+}
+
+// Delete calls the "delete" endpoint of the "admin" service.
+// Delete may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Forbidden
+//   - "NotFound" (type *goa.ServiceError): Not Found
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) Delete(ctx context.Context, p *AdminUserPayload) (err error) {
+	_, err = c.DeleteEndpoint(ctx, p)
+	return
+}
+
+// Disable calls the "disable" endpoint of the "admin" service.
+// Disable may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Forbidden
+//   - "NotFound" (type *goa.ServiceError): Not Found
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) Disable(ctx context.Context, p *AdminUserPayload) (err error) {
+	_, err = c.DisableEndpoint(ctx, p)
+	return
+}
+
+// Enable calls the "enable" endpoint of the "admin" service.
+// Enable may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Forbidden
+//   - "NotFound" (type *goa.ServiceError): Not Found
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) Enable(ctx context.Context, p *AdminUserPayload) (err error) {
+	_, err = c.EnableEndpoint(ctx, p)
+	return
+}
+
+// ResetPassword calls the "reset_password" endpoint of the "admin" service.
+// ResetPassword may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Forbidden
+//   - "NotFound" (type *goa.ServiceError): Not Found
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) ResetPassword(ctx context.Context, p *AdminUserPayload) (err error) {
+	_, err = c.ResetPasswordEndpoint(ctx, p)
+	return
+}
+
+// Unlock calls the "unlock" endpoint of the "admin" service.
+// Unlock may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Forbidden
+//   - "NotFound" (type *goa.ServiceError): Not Found
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) Unlock(ctx context.Context, p *AdminUserPayload) (err error) {
+	_, err = c.UnlockEndpoint(ctx, p)
+	return
+}
+
+// SetEmail calls the "set_email" endpoint of the "admin" service.
+// SetEmail may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "Forbidden" (type *goa.ServiceError): Forbidden
+//   - "NotFound" (type *goa.ServiceError): Not Found
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) SetEmail(ctx context.Context, p *AdminEmailPayload) (err error) {
+	_, err = c.SetEmailEndpoint(ctx, p)
+	return
+}
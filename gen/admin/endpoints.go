@@ -0,0 +1,124 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// admin endpoints
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package admin
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "admin" service endpoints.
+type Endpoints struct {
+	List          goa.Endpoint
+	Get           goa.Endpoint
+	Delete        goa.Endpoint
+	Disable       goa.Endpoint
+	Enable        goa.Endpoint
+	ResetPassword goa.Endpoint
+	Unlock        goa.Endpoint
+	SetEmail      goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "admin" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		List:          NewListEndpoint(s),
+		Get:           NewGetEndpoint(s),
+		Delete:        NewDeleteEndpoint(s),
+		Disable:       NewDisableEndpoint(s),
+		Enable:        NewEnableEndpoint(s),
+		ResetPassword: NewResetPasswordEndpoint(s),
+		Unlock:        NewUnlockEndpoint(s),
+		SetEmail:      NewSetEmailEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "admin" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.List = m(e.List)
+	e.Get = m(e.Get)
+	e.Delete = m(e.Delete)
+	e.Disable = m(e.Disable)
+	e.Enable = m(e.Enable)
+	e.ResetPassword = m(e.ResetPassword)
+	e.Unlock = m(e.Unlock)
+	e.SetEmail = m(e.SetEmail)
+}
+
+// NewListEndpoint returns an endpoint function that calls the method "list" of
+// service "admin".
+func NewListEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListUsersPayload)
+		return s.List(ctx, p)
+	}
+}
+
+// NewGetEndpoint returns an endpoint function that calls the method "get" of
+// service "admin".
+func NewGetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AdminUserPayload)
+		return s.Get(ctx, p)
+	}
+}
+
+// NewDeleteEndpoint returns an endpoint function that calls the method
+// "delete" of service "admin".
+func NewDeleteEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AdminUserPayload)
+		return nil, s.Delete(ctx, p)
+	}
+}
+
+// NewDisableEndpoint returns an endpoint function that calls the method
+// "disable" of service "admin".
+func NewDisableEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AdminUserPayload)
+		return nil, s.Disable(ctx, p)
+	}
+}
+
+// NewEnableEndpoint returns an endpoint function that calls the method
+// "enable" of service "admin".
+func NewEnableEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AdminUserPayload)
+		return nil, s.Enable(ctx, p)
+	}
+}
+
+// NewResetPasswordEndpoint returns an endpoint function that calls the method
+// "reset_password" of service "admin".
+func NewResetPasswordEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AdminUserPayload)
+		return nil, s.ResetPassword(ctx, p)
+	}
+}
+
+// NewUnlockEndpoint returns an endpoint function that calls the method
+// "unlock" of service "admin".
+func NewUnlockEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AdminUserPayload)
+		return nil, s.Unlock(ctx, p)
+	}
+}
+
+// NewSetEmailEndpoint returns an endpoint function that calls the method
+// "set_email" of service "admin".
+func NewSetEmailEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AdminEmailPayload)
+		return nil, s.SetEmail(ctx, p)
+	}
+}
@@ -0,0 +1,145 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// admin service
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package admin
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Service is the admin service interface.
+type Service interface {
+	// List implements list.
+	List(context.Context, *ListUsersPayload) (res *UserList, err error)
+	// Get implements get.
+	Get(context.Context, *AdminUserPayload) (res *AdminUser, err error)
+	// Delete implements delete.
+	Delete(context.Context, *AdminUserPayload) (err error)
+	// Disable implements disable.
+	Disable(context.Context, *AdminUserPayload) (err error)
+	// Enable implements enable.
+	Enable(context.Context, *AdminUserPayload) (err error)
+	// ResetPassword implements reset_password.
+	ResetPassword(context.Context, *AdminUserPayload) (err error)
+	// Unlock implements unlock.
+	Unlock(context.Context, *AdminUserPayload) (err error)
+	// SetEmail implements set_email.
+	SetEmail(context.Context, *AdminEmailPayload) (err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "key-stone"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "v0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "admin"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [8]string{"list", "get", "delete", "disable", "enable", "reset_password", "unlock", "set_email"}
+
+// AdminEmailPayload is the payload type of the admin service set_email method.
+type AdminEmailPayload struct {
+	// The payload of the admin
+	Authorization string
+	// The name of the user
+	Username string
+	// The new email of the user
+	Email string
+	// Whether the admin vouches for the email, else it is mailed a verification
+	// token
+	Verified bool
+}
+
+// AdminUser is the result type of the admin service get method.
+type AdminUser struct {
+	// Whether the user is disabled
+	Disabled bool
+	// The time a lockout after too many failed logins ends, if the user is locked
+	LockedUntil *string
+	// The name of the user
+	Username string
+	// The email of the user
+	Email *string
+	// Whether the user verified the email
+	EmailVerified bool
+	// The name the user is shown as
+	DisplayName *string
+	// The BCP 47 language tag the user prefers
+	Locale *string
+	// The key/value pairs of the applications
+	Attributes map[string]string
+	// The time the user was created, unknown for users of older versions
+	CreatedAt *string
+	// The time the user or an admin last changed the profile or the password
+	UpdatedAt *string
+}
+
+// AdminUserPayload is the payload type of the admin service get method.
+type AdminUserPayload struct {
+	// The payload of the admin
+	Authorization string
+	// The name of the user
+	Username string
+}
+
+// ListUsersPayload is the payload type of the admin service list method.
+type ListUsersPayload struct {
+	// The payload of the admin
+	Authorization string
+	// The next of the previous page, omitted for the first page
+	After *string
+	// Only users whose username or email contains it, case-sensitively
+	Search *string
+	// The most users on a page
+	Limit int
+}
+
+// UserList is the result type of the admin service list method.
+type UserList struct {
+	// The users of the page, ordered by username
+	Users []*AdminUser
+	// The after of the next page, omitted on the last page
+	Next *string
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "BadRequest", false, false, false)
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "Unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "Forbidden", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "NotFound", false, false, false)
+}
+
+// MakeInternalServerError builds a goa.ServiceError from an error.
+func MakeInternalServerError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "InternalServerError", false, false, false)
+}
+
+// MakeNotImplemented builds a goa.ServiceError from an error.
+func MakeNotImplemented(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "NotImplemented", false, false, false)
+}
@@ -0,0 +1,36 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// discovery client
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package discovery
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "discovery" service client.
+type Client struct {
+	JwksEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "discovery" service client given the endpoints.
+func NewClient(jwks goa.Endpoint) *Client {
+	return &Client{
+		JwksEndpoint: jwks,
+	}
+}
+
+// Jwks calls the "jwks" endpoint of the "discovery" service.
+func (c *Client) Jwks(ctx context.Context) (res *JSONWebKeySet, err error) {
+	var ires any
+	ires, err = c.JwksEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*JSONWebKeySet), nil
+}
@@ -0,0 +1,39 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// discovery endpoints
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package discovery
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "discovery" service endpoints.
+type Endpoints struct {
+	Jwks goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "discovery" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		Jwks: NewJwksEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "discovery" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.Jwks = m(e.Jwks)
+}
+
+// NewJwksEndpoint returns an endpoint function that calls the method "jwks" of
+// service "discovery".
+func NewJwksEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.Jwks(ctx)
+	}
+}
@@ -0,0 +1,61 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// discovery service
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package discovery
+
+import (
+	"context"
+)
+
+// Service is the discovery service interface.
+type Service interface {
+	// Jwks implements jwks.
+	Jwks(context.Context) (res *JSONWebKeySet, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "key-stone"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "v0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "discovery"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [1]string{"jwks"}
+
+type JSONWebKey struct {
+	// The key type
+	Kty string
+	// The intended use of the key
+	Use *string
+	// The algorithm the key is used with
+	Alg *string
+	// The key ID
+	Kid *string
+	// The RSA modulus
+	N *string
+	// The RSA public exponent
+	E *string
+	// The curve of the EC or OKP key
+	Crv *string
+	// The x coordinate of the EC key or the OKP public key
+	X *string
+	// The y coordinate of the EC key
+	Y *string
+}
+
+// JSONWebKeySet is the result type of the discovery service jwks method.
+type JSONWebKeySet struct {
+	// The keys that verify access tokens
+	Keys []*JSONWebKey
+}
@@ -0,0 +1,212 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// admin HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	admin "github.com/neatflowcv/key-stone/gen/admin"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListPayload builds the payload for the admin list endpoint from CLI
+// flags.
+func BuildListPayload(adminListAfter string, adminListSearch string, adminListLimit string, adminListAuthorization string) (*admin.ListUsersPayload, error) {
+	var err error
+	var after *string
+	{
+		if adminListAfter != "" {
+			after = &adminListAfter
+		}
+	}
+	var search *string
+	{
+		if adminListSearch != "" {
+			search = &adminListSearch
+		}
+	}
+	var limit int
+	{
+		if adminListLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(adminListLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var authorization string
+	{
+		authorization = adminListAuthorization
+	}
+	v := &admin.ListUsersPayload{}
+	v.After = after
+	v.Search = search
+	v.Limit = limit
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildGetPayload builds the payload for the admin get endpoint from CLI flags.
+func BuildGetPayload(adminGetUsername string, adminGetAuthorization string) (*admin.AdminUserPayload, error) {
+	var username string
+	{
+		username = adminGetUsername
+	}
+	var authorization string
+	{
+		authorization = adminGetAuthorization
+	}
+	v := &admin.AdminUserPayload{}
+	v.Username = username
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildDeletePayload builds the payload for the admin delete endpoint from CLI
+// flags.
+func BuildDeletePayload(adminDeleteUsername string, adminDeleteAuthorization string) (*admin.AdminUserPayload, error) {
+	var username string
+	{
+		username = adminDeleteUsername
+	}
+	var authorization string
+	{
+		authorization = adminDeleteAuthorization
+	}
+	v := &admin.AdminUserPayload{}
+	v.Username = username
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildDisablePayload builds the payload for the admin disable endpoint from
+// CLI flags.
+func BuildDisablePayload(adminDisableUsername string, adminDisableAuthorization string) (*admin.AdminUserPayload, error) {
+	var username string
+	{
+		username = adminDisableUsername
+	}
+	var authorization string
+	{
+		authorization = adminDisableAuthorization
+	}
+	v := &admin.AdminUserPayload{}
+	v.Username = username
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildEnablePayload builds the payload for the admin enable endpoint from CLI
+// flags.
+func BuildEnablePayload(adminEnableUsername string, adminEnableAuthorization string) (*admin.AdminUserPayload, error) {
+	var username string
+	{
+		username = adminEnableUsername
+	}
+	var authorization string
+	{
+		authorization = adminEnableAuthorization
+	}
+	v := &admin.AdminUserPayload{}
+	v.Username = username
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildResetPasswordPayload builds the payload for the admin reset_password
+// endpoint from CLI flags.
+func BuildResetPasswordPayload(adminResetPasswordUsername string, adminResetPasswordAuthorization string) (*admin.AdminUserPayload, error) {
+	var username string
+	{
+		username = adminResetPasswordUsername
+	}
+	var authorization string
+	{
+		authorization = adminResetPasswordAuthorization
+	}
+	v := &admin.AdminUserPayload{}
+	v.Username = username
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildUnlockPayload builds the payload for the admin unlock endpoint from CLI
+// flags.
+func BuildUnlockPayload(adminUnlockUsername string, adminUnlockAuthorization string) (*admin.AdminUserPayload, error) {
+	var username string
+	{
+		username = adminUnlockUsername
+	}
+	var authorization string
+	{
+		authorization = adminUnlockAuthorization
+	}
+	v := &admin.AdminUserPayload{}
+	v.Username = username
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildSetEmailPayload builds the payload for the admin set_email endpoint
+// from CLI flags.
+func BuildSetEmailPayload(adminSetEmailBody string, adminSetEmailUsername string, adminSetEmailAuthorization string) (*admin.AdminEmailPayload, error) {
+	var err error
+	var body SetEmailRequestBody
+	{
+		err = json.Unmarshal([]byte(adminSetEmailBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"nasir_bartell@tremblay.org\",\n      \"verified\": false\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var username string
+	{
+		username = adminSetEmailUsername
+	}
+	var authorization string
+	{
+		authorization = adminSetEmailAuthorization
+	}
+	v := &admin.AdminEmailPayload{
+		Email:    body.Email,
+		Verified: body.Verified,
+	}
+	{
+		var zero bool
+		if v.Verified == zero {
+			v.Verified = false
+		}
+	}
+	v.Username = username
+	v.Authorization = authorization
+
+	return v, nil
+}
@@ -0,0 +1,273 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// admin client HTTP transport
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the admin service endpoint HTTP clients.
+type Client struct {
+	// List Doer is the HTTP client used to make requests to the list endpoint.
+	ListDoer goahttp.Doer
+
+	// Get Doer is the HTTP client used to make requests to the get endpoint.
+	GetDoer goahttp.Doer
+
+	// Delete Doer is the HTTP client used to make requests to the delete endpoint.
+	DeleteDoer goahttp.Doer
+
+	// Disable Doer is the HTTP client used to make requests to the disable
+	// endpoint.
+	DisableDoer goahttp.Doer
+
+	// Enable Doer is the HTTP client used to make requests to the enable endpoint.
+	EnableDoer goahttp.Doer
+
+	// ResetPassword Doer is the HTTP client used to make requests to the
+	// reset_password endpoint.
+	ResetPasswordDoer goahttp.Doer
+
+	// Unlock Doer is the HTTP client used to make requests to the unlock endpoint.
+	UnlockDoer goahttp.Doer
+
+	// SetEmail Doer is the HTTP client used to make requests to the set_email
+	// endpoint.
+	SetEmailDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the admin service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		ListDoer:            doer,
+		GetDoer:             doer,
+		DeleteDoer:          doer,
+		DisableDoer:         doer,
+		EnableDoer:          doer,
+		ResetPasswordDoer:   doer,
+		UnlockDoer:          doer,
+		SetEmailDoer:        doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// List returns an endpoint that makes HTTP requests to the admin service list
+// server.
+func (c *Client) List() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListRequest(c.encoder)
+		decodeResponse = DecodeListResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "list", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Get returns an endpoint that makes HTTP requests to the admin service get
+// server.
+func (c *Client) Get() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetRequest(c.encoder)
+		decodeResponse = DecodeGetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "get", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Delete returns an endpoint that makes HTTP requests to the admin service
+// delete server.
+func (c *Client) Delete() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteRequest(c.encoder)
+		decodeResponse = DecodeDeleteResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "delete", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Disable returns an endpoint that makes HTTP requests to the admin service
+// disable server.
+func (c *Client) Disable() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDisableRequest(c.encoder)
+		decodeResponse = DecodeDisableResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDisableRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DisableDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "disable", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Enable returns an endpoint that makes HTTP requests to the admin service
+// enable server.
+func (c *Client) Enable() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeEnableRequest(c.encoder)
+		decodeResponse = DecodeEnableResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildEnableRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.EnableDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "enable", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ResetPassword returns an endpoint that makes HTTP requests to the admin
+// service reset_password server.
+func (c *Client) ResetPassword() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeResetPasswordRequest(c.encoder)
+		decodeResponse = DecodeResetPasswordResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildResetPasswordRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ResetPasswordDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "reset_password", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Unlock returns an endpoint that makes HTTP requests to the admin service
+// unlock server.
+func (c *Client) Unlock() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUnlockRequest(c.encoder)
+		decodeResponse = DecodeUnlockResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUnlockRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UnlockDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "unlock", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetEmail returns an endpoint that makes HTTP requests to the admin service
+// set_email server.
+func (c *Client) SetEmail() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetEmailRequest(c.encoder)
+		decodeResponse = DecodeSetEmailResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetEmailRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetEmailDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "set_email", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,1158 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// admin HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	admin "github.com/neatflowcv/key-stone/gen/admin"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildListRequest instantiates a HTTP request object with method and path set
+// to call the "admin" service "list" endpoint
+func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAdminPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "list", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListRequest returns an encoder for requests sent to the admin list
+// server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.ListUsersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "list", "*admin.ListUsersPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		values := req.URL.Query()
+		if p.After != nil {
+			values.Add("after", *p.After)
+		}
+		if p.Search != nil {
+			values.Add("search", *p.Search)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListResponse returns a decoder for responses returned by the admin
+// list endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeListResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "list", err)
+			}
+			err = ValidateListResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "list", err)
+			}
+			res := NewListUserListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "list", err)
+			}
+			err = ValidateListBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "list", err)
+			}
+			return nil, NewListBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "list", err)
+			}
+			err = ValidateListUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "list", err)
+			}
+			return nil, NewListUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "list", err)
+			}
+			err = ValidateListForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "list", err)
+			}
+			return nil, NewListForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "list", err)
+			}
+			err = ValidateListInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "list", err)
+			}
+			return nil, NewListInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "list", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRequest instantiates a HTTP request object with method and path set
+// to call the "admin" service "get" endpoint
+func (c *Client) BuildGetRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "get", "*admin.AdminUserPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAdminPath(username)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "get", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRequest returns an encoder for requests sent to the admin get
+// server.
+func EncodeGetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "get", "*admin.AdminUserPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeGetResponse returns a decoder for responses returned by the admin get
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+// DecodeGetResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "NotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "get", err)
+			}
+			err = ValidateGetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "get", err)
+			}
+			res := NewGetAdminUserOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body GetUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "get", err)
+			}
+			err = ValidateGetUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "get", err)
+			}
+			return nil, NewGetUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body GetForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "get", err)
+			}
+			err = ValidateGetForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "get", err)
+			}
+			return nil, NewGetForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body GetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "get", err)
+			}
+			err = ValidateGetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "get", err)
+			}
+			return nil, NewGetNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "get", err)
+			}
+			err = ValidateGetInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "get", err)
+			}
+			return nil, NewGetInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "get", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "admin" service "delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "delete", "*admin.AdminUserPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteAdminPath(username)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteRequest returns an encoder for requests sent to the admin delete
+// server.
+func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "delete", "*admin.AdminUserPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the admin
+// delete endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeDeleteResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "NotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body DeleteUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "delete", err)
+			}
+			err = ValidateDeleteUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "delete", err)
+			}
+			return nil, NewDeleteUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "delete", err)
+			}
+			err = ValidateDeleteForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "delete", err)
+			}
+			return nil, NewDeleteForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "delete", err)
+			}
+			err = ValidateDeleteNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "delete", err)
+			}
+			return nil, NewDeleteNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "delete", err)
+			}
+			err = ValidateDeleteInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "delete", err)
+			}
+			return nil, NewDeleteInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDisableRequest instantiates a HTTP request object with method and path
+// set to call the "admin" service "disable" endpoint
+func (c *Client) BuildDisableRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "disable", "*admin.AdminUserPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DisableAdminPath(username)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "disable", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDisableRequest returns an encoder for requests sent to the admin
+// disable server.
+func EncodeDisableRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "disable", "*admin.AdminUserPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDisableResponse returns a decoder for responses returned by the admin
+// disable endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeDisableResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "NotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDisableResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body DisableUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "disable", err)
+			}
+			err = ValidateDisableUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "disable", err)
+			}
+			return nil, NewDisableUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DisableForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "disable", err)
+			}
+			err = ValidateDisableForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "disable", err)
+			}
+			return nil, NewDisableForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body DisableNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "disable", err)
+			}
+			err = ValidateDisableNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "disable", err)
+			}
+			return nil, NewDisableNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DisableInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "disable", err)
+			}
+			err = ValidateDisableInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "disable", err)
+			}
+			return nil, NewDisableInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "disable", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildEnableRequest instantiates a HTTP request object with method and path
+// set to call the "admin" service "enable" endpoint
+func (c *Client) BuildEnableRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "enable", "*admin.AdminUserPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: EnableAdminPath(username)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "enable", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeEnableRequest returns an encoder for requests sent to the admin enable
+// server.
+func EncodeEnableRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "enable", "*admin.AdminUserPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeEnableResponse returns a decoder for responses returned by the admin
+// enable endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeEnableResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "NotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeEnableResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body EnableUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "enable", err)
+			}
+			err = ValidateEnableUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "enable", err)
+			}
+			return nil, NewEnableUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body EnableForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "enable", err)
+			}
+			err = ValidateEnableForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "enable", err)
+			}
+			return nil, NewEnableForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body EnableNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "enable", err)
+			}
+			err = ValidateEnableNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "enable", err)
+			}
+			return nil, NewEnableNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body EnableInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "enable", err)
+			}
+			err = ValidateEnableInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "enable", err)
+			}
+			return nil, NewEnableInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "enable", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildResetPasswordRequest instantiates a HTTP request object with method and
+// path set to call the "admin" service "reset_password" endpoint
+func (c *Client) BuildResetPasswordRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "reset_password", "*admin.AdminUserPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResetPasswordAdminPath(username)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "reset_password", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeResetPasswordRequest returns an encoder for requests sent to the admin
+// reset_password server.
+func EncodeResetPasswordRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "reset_password", "*admin.AdminUserPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeResetPasswordResponse returns a decoder for responses returned by the
+// admin reset_password endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeResetPasswordResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "NotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "NotImplemented" (type *goa.ServiceError): http.StatusNotImplemented
+//   - error: internal error
+func DecodeResetPasswordResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body ResetPasswordBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "reset_password", err)
+			}
+			err = ValidateResetPasswordBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "reset_password", err)
+			}
+			return nil, NewResetPasswordBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ResetPasswordUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "reset_password", err)
+			}
+			err = ValidateResetPasswordUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "reset_password", err)
+			}
+			return nil, NewResetPasswordUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ResetPasswordForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "reset_password", err)
+			}
+			err = ValidateResetPasswordForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "reset_password", err)
+			}
+			return nil, NewResetPasswordForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body ResetPasswordNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "reset_password", err)
+			}
+			err = ValidateResetPasswordNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "reset_password", err)
+			}
+			return nil, NewResetPasswordNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ResetPasswordInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "reset_password", err)
+			}
+			err = ValidateResetPasswordInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "reset_password", err)
+			}
+			return nil, NewResetPasswordInternalServerError(&body)
+		case http.StatusNotImplemented:
+			var (
+				body ResetPasswordNotImplementedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "reset_password", err)
+			}
+			err = ValidateResetPasswordNotImplementedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "reset_password", err)
+			}
+			return nil, NewResetPasswordNotImplemented(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "reset_password", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUnlockRequest instantiates a HTTP request object with method and path
+// set to call the "admin" service "unlock" endpoint
+func (c *Client) BuildUnlockRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "unlock", "*admin.AdminUserPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UnlockAdminPath(username)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "unlock", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUnlockRequest returns an encoder for requests sent to the admin unlock
+// server.
+func EncodeUnlockRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.AdminUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "unlock", "*admin.AdminUserPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeUnlockResponse returns a decoder for responses returned by the admin
+// unlock endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeUnlockResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "NotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUnlockResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body UnlockUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "unlock", err)
+			}
+			err = ValidateUnlockUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "unlock", err)
+			}
+			return nil, NewUnlockUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body UnlockForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "unlock", err)
+			}
+			err = ValidateUnlockForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "unlock", err)
+			}
+			return nil, NewUnlockForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body UnlockNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "unlock", err)
+			}
+			err = ValidateUnlockNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "unlock", err)
+			}
+			return nil, NewUnlockNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UnlockInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "unlock", err)
+			}
+			err = ValidateUnlockInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "unlock", err)
+			}
+			return nil, NewUnlockInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "unlock", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetEmailRequest instantiates a HTTP request object with method and path
+// set to call the "admin" service "set_email" endpoint
+func (c *Client) BuildSetEmailRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*admin.AdminEmailPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "set_email", "*admin.AdminEmailPayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetEmailAdminPath(username)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "set_email", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetEmailRequest returns an encoder for requests sent to the admin
+// set_email server.
+func EncodeSetEmailRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.AdminEmailPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "set_email", "*admin.AdminEmailPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		body := NewSetEmailRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("admin", "set_email", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetEmailResponse returns a decoder for responses returned by the admin
+// set_email endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeSetEmailResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "Forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "NotFound" (type *goa.ServiceError): http.StatusNotFound
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeSetEmailResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body SetEmailBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "set_email", err)
+			}
+			err = ValidateSetEmailBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "set_email", err)
+			}
+			return nil, NewSetEmailBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SetEmailUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "set_email", err)
+			}
+			err = ValidateSetEmailUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "set_email", err)
+			}
+			return nil, NewSetEmailUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body SetEmailForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "set_email", err)
+			}
+			err = ValidateSetEmailForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "set_email", err)
+			}
+			return nil, NewSetEmailForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body SetEmailNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "set_email", err)
+			}
+			err = ValidateSetEmailNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "set_email", err)
+			}
+			return nil, NewSetEmailNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SetEmailInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "set_email", err)
+			}
+			err = ValidateSetEmailInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "set_email", err)
+			}
+			return nil, NewSetEmailInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "set_email", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalAdminUserResponseBodyToAdminAdminUser builds a value of type
+// *admin.AdminUser from a value of type *AdminUserResponseBody.
+func unmarshalAdminUserResponseBodyToAdminAdminUser(v *AdminUserResponseBody) *admin.AdminUser {
+	res := &admin.AdminUser{
+		Disabled:      *v.Disabled,
+		LockedUntil:   v.LockedUntil,
+		Username:      *v.Username,
+		Email:         v.Email,
+		EmailVerified: *v.EmailVerified,
+		DisplayName:   v.DisplayName,
+		Locale:        v.Locale,
+		CreatedAt:     v.CreatedAt,
+		UpdatedAt:     v.UpdatedAt,
+	}
+	res.Attributes = make(map[string]string, len(v.Attributes))
+	for key, val := range v.Attributes {
+		tk := key
+		tv := val
+		res.Attributes[tk] = tv
+	}
+
+	return res
+}
@@ -0,0 +1,52 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// HTTP request path constructors for the admin service.
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// ListAdminPath returns the URL path to the admin service list HTTP endpoint.
+func ListAdminPath() string {
+	return "/key-stone/admin/users"
+}
+
+// GetAdminPath returns the URL path to the admin service get HTTP endpoint.
+func GetAdminPath(username string) string {
+	return fmt.Sprintf("/key-stone/admin/users/%v", username)
+}
+
+// DeleteAdminPath returns the URL path to the admin service delete HTTP endpoint.
+func DeleteAdminPath(username string) string {
+	return fmt.Sprintf("/key-stone/admin/users/%v", username)
+}
+
+// DisableAdminPath returns the URL path to the admin service disable HTTP endpoint.
+func DisableAdminPath(username string) string {
+	return fmt.Sprintf("/key-stone/admin/users/%v/disable", username)
+}
+
+// EnableAdminPath returns the URL path to the admin service enable HTTP endpoint.
+func EnableAdminPath(username string) string {
+	return fmt.Sprintf("/key-stone/admin/users/%v/enable", username)
+}
+
+// ResetPasswordAdminPath returns the URL path to the admin service reset_password HTTP endpoint.
+func ResetPasswordAdminPath(username string) string {
+	return fmt.Sprintf("/key-stone/admin/users/%v/password-reset", username)
+}
+
+// UnlockAdminPath returns the URL path to the admin service unlock HTTP endpoint.
+func UnlockAdminPath(username string) string {
+	return fmt.Sprintf("/key-stone/admin/users/%v/unlock", username)
+}
+
+// SetEmailAdminPath returns the URL path to the admin service set_email HTTP endpoint.
+func SetEmailAdminPath(username string) string {
+	return fmt.Sprintf("/key-stone/admin/users/%v/email", username)
+}
@@ -13,6 +13,8 @@ import (
 	"net/http"
 	"os"
 
+	adminc "github.com/neatflowcv/key-stone/gen/http/admin/client"
+	discoveryc "github.com/neatflowcv/key-stone/gen/http/discovery/client"
 	tokenc "github.com/neatflowcv/key-stone/gen/http/token/client"
 	userc "github.com/neatflowcv/key-stone/gen/http/user/client"
 	goahttp "goa.design/goa/v3/http"
@@ -24,21 +26,33 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"user (create|delete)",
-		"token (issue|refresh)",
+		"admin (list|get|delete|disable|enable|reset-password|unlock|set-email)",
+		"user (create|delete|get-me|update-me|change-password|request-password-reset|reset-password|request-email-verification|verify-email)",
+		"token (issue|refresh|revoke|introspect)",
+		"discovery jwks",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` user create --body '{
-      "password": "Occaecati vel adipisci.",
-      "username": "Quia architecto veritatis deleniti ea perferendis."
+	return os.Args[0] + ` admin list --after "Et dignissimos reprehenderit nihil." --search "Tenetur vel eveniet." --limit 174 --authorization "Sit quis."` + "\n" +
+		os.Args[0] + ` user create --body '{
+      "email": "eleonore@kilback.com",
+      "password": "A earum placeat sed sint.",
+      "username": "zgz"
    }'` + "\n" +
 		os.Args[0] + ` token issue --body '{
-      "password": "Illo et rerum quia odit voluptas.",
-      "username": "Esse sapiente temporibus vel."
+      "audience": [
+         "Eligendi dolores incidunt magni eaque id.",
+         "Quibusdam earum deserunt."
+      ],
+      "client_id": "Non repellat pariatur facere non.",
+      "client_secret": "Natus unde qui rerum.",
+      "password": "Deleniti rerum qui recusandae dicta sed.",
+      "scope": "Ex aspernatur et est deserunt vitae.",
+      "username": "1mp"
    }'` + "\n" +
+		os.Args[0] + ` discovery jwks` + "\n" +
 		""
 }
 
@@ -52,6 +66,43 @@ func ParseEndpoint(
 	restore bool,
 ) (goa.Endpoint, any, error) {
 	var (
+		adminFlags = flag.NewFlagSet("admin", flag.ContinueOnError)
+
+		adminListFlags             = flag.NewFlagSet("list", flag.ExitOnError)
+		adminListAfterFlag         = adminListFlags.String("after", "", "")
+		adminListSearchFlag        = adminListFlags.String("search", "", "")
+		adminListLimitFlag         = adminListFlags.String("limit", "50", "")
+		adminListAuthorizationFlag = adminListFlags.String("authorization", "REQUIRED", "")
+
+		adminGetFlags             = flag.NewFlagSet("get", flag.ExitOnError)
+		adminGetUsernameFlag      = adminGetFlags.String("username", "REQUIRED", "The name of the user")
+		adminGetAuthorizationFlag = adminGetFlags.String("authorization", "REQUIRED", "")
+
+		adminDeleteFlags             = flag.NewFlagSet("delete", flag.ExitOnError)
+		adminDeleteUsernameFlag      = adminDeleteFlags.String("username", "REQUIRED", "The name of the user")
+		adminDeleteAuthorizationFlag = adminDeleteFlags.String("authorization", "REQUIRED", "")
+
+		adminDisableFlags             = flag.NewFlagSet("disable", flag.ExitOnError)
+		adminDisableUsernameFlag      = adminDisableFlags.String("username", "REQUIRED", "The name of the user")
+		adminDisableAuthorizationFlag = adminDisableFlags.String("authorization", "REQUIRED", "")
+
+		adminEnableFlags             = flag.NewFlagSet("enable", flag.ExitOnError)
+		adminEnableUsernameFlag      = adminEnableFlags.String("username", "REQUIRED", "The name of the user")
+		adminEnableAuthorizationFlag = adminEnableFlags.String("authorization", "REQUIRED", "")
+
+		adminResetPasswordFlags             = flag.NewFlagSet("reset-password", flag.ExitOnError)
+		adminResetPasswordUsernameFlag      = adminResetPasswordFlags.String("username", "REQUIRED", "The name of the user")
+		adminResetPasswordAuthorizationFlag = adminResetPasswordFlags.String("authorization", "REQUIRED", "")
+
+		adminUnlockFlags             = flag.NewFlagSet("unlock", flag.ExitOnError)
+		adminUnlockUsernameFlag      = adminUnlockFlags.String("username", "REQUIRED", "The name of the user")
+		adminUnlockAuthorizationFlag = adminUnlockFlags.String("authorization", "REQUIRED", "")
+
+		adminSetEmailFlags             = flag.NewFlagSet("set-email", flag.ExitOnError)
+		adminSetEmailBodyFlag          = adminSetEmailFlags.String("body", "REQUIRED", "")
+		adminSetEmailUsernameFlag      = adminSetEmailFlags.String("username", "REQUIRED", "The name of the user")
+		adminSetEmailAuthorizationFlag = adminSetEmailFlags.String("authorization", "REQUIRED", "")
+
 		userFlags = flag.NewFlagSet("user", flag.ContinueOnError)
 
 		userCreateFlags    = flag.NewFlagSet("create", flag.ExitOnError)
@@ -60,6 +111,29 @@ func ParseEndpoint(
 		userDeleteFlags             = flag.NewFlagSet("delete", flag.ExitOnError)
 		userDeleteAuthorizationFlag = userDeleteFlags.String("authorization", "REQUIRED", "")
 
+		userGetMeFlags             = flag.NewFlagSet("get-me", flag.ExitOnError)
+		userGetMeAuthorizationFlag = userGetMeFlags.String("authorization", "REQUIRED", "")
+
+		userUpdateMeFlags             = flag.NewFlagSet("update-me", flag.ExitOnError)
+		userUpdateMeBodyFlag          = userUpdateMeFlags.String("body", "REQUIRED", "")
+		userUpdateMeAuthorizationFlag = userUpdateMeFlags.String("authorization", "REQUIRED", "")
+
+		userChangePasswordFlags             = flag.NewFlagSet("change-password", flag.ExitOnError)
+		userChangePasswordBodyFlag          = userChangePasswordFlags.String("body", "REQUIRED", "")
+		userChangePasswordAuthorizationFlag = userChangePasswordFlags.String("authorization", "REQUIRED", "")
+
+		userRequestPasswordResetFlags    = flag.NewFlagSet("request-password-reset", flag.ExitOnError)
+		userRequestPasswordResetBodyFlag = userRequestPasswordResetFlags.String("body", "REQUIRED", "")
+
+		userResetPasswordFlags    = flag.NewFlagSet("reset-password", flag.ExitOnError)
+		userResetPasswordBodyFlag = userResetPasswordFlags.String("body", "REQUIRED", "")
+
+		userRequestEmailVerificationFlags    = flag.NewFlagSet("request-email-verification", flag.ExitOnError)
+		userRequestEmailVerificationBodyFlag = userRequestEmailVerificationFlags.String("body", "REQUIRED", "")
+
+		userVerifyEmailFlags    = flag.NewFlagSet("verify-email", flag.ExitOnError)
+		userVerifyEmailBodyFlag = userVerifyEmailFlags.String("body", "REQUIRED", "")
+
 		tokenFlags = flag.NewFlagSet("token", flag.ContinueOnError)
 
 		tokenIssueFlags    = flag.NewFlagSet("issue", flag.ExitOnError)
@@ -67,14 +141,50 @@ func ParseEndpoint(
 
 		tokenRefreshFlags    = flag.NewFlagSet("refresh", flag.ExitOnError)
 		tokenRefreshBodyFlag = tokenRefreshFlags.String("body", "REQUIRED", "")
+
+		tokenRevokeFlags            = flag.NewFlagSet("revoke", flag.ExitOnError)
+		tokenRevokeBodyFlag         = tokenRevokeFlags.String("body", "REQUIRED", "")
+		tokenRevokeClientIDFlag     = tokenRevokeFlags.String("client-id", "REQUIRED", "The ID of the client")
+		tokenRevokeClientSecretFlag = tokenRevokeFlags.String("client-secret", "REQUIRED", "The secret of the client")
+
+		tokenIntrospectFlags            = flag.NewFlagSet("introspect", flag.ExitOnError)
+		tokenIntrospectBodyFlag         = tokenIntrospectFlags.String("body", "REQUIRED", "")
+		tokenIntrospectClientIDFlag     = tokenIntrospectFlags.String("client-id", "REQUIRED", "The ID of the client")
+		tokenIntrospectClientSecretFlag = tokenIntrospectFlags.String("client-secret", "REQUIRED", "The secret of the client")
+
+		discoveryFlags = flag.NewFlagSet("discovery", flag.ContinueOnError)
+
+		discoveryJwksFlags = flag.NewFlagSet("jwks", flag.ExitOnError)
 	)
+	adminFlags.Usage = adminUsage
+	adminListFlags.Usage = adminListUsage
+	adminGetFlags.Usage = adminGetUsage
+	adminDeleteFlags.Usage = adminDeleteUsage
+	adminDisableFlags.Usage = adminDisableUsage
+	adminEnableFlags.Usage = adminEnableUsage
+	adminResetPasswordFlags.Usage = adminResetPasswordUsage
+	adminUnlockFlags.Usage = adminUnlockUsage
+	adminSetEmailFlags.Usage = adminSetEmailUsage
+
 	userFlags.Usage = userUsage
 	userCreateFlags.Usage = userCreateUsage
 	userDeleteFlags.Usage = userDeleteUsage
+	userGetMeFlags.Usage = userGetMeUsage
+	userUpdateMeFlags.Usage = userUpdateMeUsage
+	userChangePasswordFlags.Usage = userChangePasswordUsage
+	userRequestPasswordResetFlags.Usage = userRequestPasswordResetUsage
+	userResetPasswordFlags.Usage = userResetPasswordUsage
+	userRequestEmailVerificationFlags.Usage = userRequestEmailVerificationUsage
+	userVerifyEmailFlags.Usage = userVerifyEmailUsage
 
 	tokenFlags.Usage = tokenUsage
 	tokenIssueFlags.Usage = tokenIssueUsage
 	tokenRefreshFlags.Usage = tokenRefreshUsage
+	tokenRevokeFlags.Usage = tokenRevokeUsage
+	tokenIntrospectFlags.Usage = tokenIntrospectUsage
+
+	discoveryFlags.Usage = discoveryUsage
+	discoveryJwksFlags.Usage = discoveryJwksUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -91,10 +201,14 @@ func ParseEndpoint(
 	{
 		svcn = flag.Arg(0)
 		switch svcn {
+		case "admin":
+			svcf = adminFlags
 		case "user":
 			svcf = userFlags
 		case "token":
 			svcf = tokenFlags
+		case "discovery":
+			svcf = discoveryFlags
 		default:
 			return nil, nil, fmt.Errorf("unknown service %q", svcn)
 		}
@@ -110,6 +224,34 @@ func ParseEndpoint(
 	{
 		epn = svcf.Arg(0)
 		switch svcn {
+		case "admin":
+			switch epn {
+			case "list":
+				epf = adminListFlags
+
+			case "get":
+				epf = adminGetFlags
+
+			case "delete":
+				epf = adminDeleteFlags
+
+			case "disable":
+				epf = adminDisableFlags
+
+			case "enable":
+				epf = adminEnableFlags
+
+			case "reset-password":
+				epf = adminResetPasswordFlags
+
+			case "unlock":
+				epf = adminUnlockFlags
+
+			case "set-email":
+				epf = adminSetEmailFlags
+
+			}
+
 		case "user":
 			switch epn {
 			case "create":
@@ -118,6 +260,27 @@ func ParseEndpoint(
 			case "delete":
 				epf = userDeleteFlags
 
+			case "get-me":
+				epf = userGetMeFlags
+
+			case "update-me":
+				epf = userUpdateMeFlags
+
+			case "change-password":
+				epf = userChangePasswordFlags
+
+			case "request-password-reset":
+				epf = userRequestPasswordResetFlags
+
+			case "reset-password":
+				epf = userResetPasswordFlags
+
+			case "request-email-verification":
+				epf = userRequestEmailVerificationFlags
+
+			case "verify-email":
+				epf = userVerifyEmailFlags
+
 			}
 
 		case "token":
@@ -128,6 +291,19 @@ func ParseEndpoint(
 			case "refresh":
 				epf = tokenRefreshFlags
 
+			case "revoke":
+				epf = tokenRevokeFlags
+
+			case "introspect":
+				epf = tokenIntrospectFlags
+
+			}
+
+		case "discovery":
+			switch epn {
+			case "jwks":
+				epf = discoveryJwksFlags
+
 			}
 
 		}
@@ -150,6 +326,34 @@ func ParseEndpoint(
 	)
 	{
 		switch svcn {
+		case "admin":
+			c := adminc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list":
+				endpoint = c.List()
+				data, err = adminc.BuildListPayload(*adminListAfterFlag, *adminListSearchFlag, *adminListLimitFlag, *adminListAuthorizationFlag)
+			case "get":
+				endpoint = c.Get()
+				data, err = adminc.BuildGetPayload(*adminGetUsernameFlag, *adminGetAuthorizationFlag)
+			case "delete":
+				endpoint = c.Delete()
+				data, err = adminc.BuildDeletePayload(*adminDeleteUsernameFlag, *adminDeleteAuthorizationFlag)
+			case "disable":
+				endpoint = c.Disable()
+				data, err = adminc.BuildDisablePayload(*adminDisableUsernameFlag, *adminDisableAuthorizationFlag)
+			case "enable":
+				endpoint = c.Enable()
+				data, err = adminc.BuildEnablePayload(*adminEnableUsernameFlag, *adminEnableAuthorizationFlag)
+			case "reset-password":
+				endpoint = c.ResetPassword()
+				data, err = adminc.BuildResetPasswordPayload(*adminResetPasswordUsernameFlag, *adminResetPasswordAuthorizationFlag)
+			case "unlock":
+				endpoint = c.Unlock()
+				data, err = adminc.BuildUnlockPayload(*adminUnlockUsernameFlag, *adminUnlockAuthorizationFlag)
+			case "set-email":
+				endpoint = c.SetEmail()
+				data, err = adminc.BuildSetEmailPayload(*adminSetEmailBodyFlag, *adminSetEmailUsernameFlag, *adminSetEmailAuthorizationFlag)
+			}
 		case "user":
 			c := userc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
@@ -159,6 +363,27 @@ func ParseEndpoint(
 			case "delete":
 				endpoint = c.Delete()
 				data, err = userc.BuildDeletePayload(*userDeleteAuthorizationFlag)
+			case "get-me":
+				endpoint = c.GetMe()
+				data, err = userc.BuildGetMePayload(*userGetMeAuthorizationFlag)
+			case "update-me":
+				endpoint = c.UpdateMe()
+				data, err = userc.BuildUpdateMePayload(*userUpdateMeBodyFlag, *userUpdateMeAuthorizationFlag)
+			case "change-password":
+				endpoint = c.ChangePassword()
+				data, err = userc.BuildChangePasswordPayload(*userChangePasswordBodyFlag, *userChangePasswordAuthorizationFlag)
+			case "request-password-reset":
+				endpoint = c.RequestPasswordReset()
+				data, err = userc.BuildRequestPasswordResetPayload(*userRequestPasswordResetBodyFlag)
+			case "reset-password":
+				endpoint = c.ResetPassword()
+				data, err = userc.BuildResetPasswordPayload(*userResetPasswordBodyFlag)
+			case "request-email-verification":
+				endpoint = c.RequestEmailVerification()
+				data, err = userc.BuildRequestEmailVerificationPayload(*userRequestEmailVerificationBodyFlag)
+			case "verify-email":
+				endpoint = c.VerifyEmail()
+				data, err = userc.BuildVerifyEmailPayload(*userVerifyEmailBodyFlag)
 			}
 		case "token":
 			c := tokenc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -169,6 +394,18 @@ func ParseEndpoint(
 			case "refresh":
 				endpoint = c.Refresh()
 				data, err = tokenc.BuildRefreshPayload(*tokenRefreshBodyFlag)
+			case "revoke":
+				endpoint = c.Revoke()
+				data, err = tokenc.BuildRevokePayload(*tokenRevokeBodyFlag, *tokenRevokeClientIDFlag, *tokenRevokeClientSecretFlag)
+			case "introspect":
+				endpoint = c.Introspect()
+				data, err = tokenc.BuildIntrospectPayload(*tokenIntrospectBodyFlag, *tokenIntrospectClientIDFlag, *tokenIntrospectClientSecretFlag)
+			}
+		case "discovery":
+			c := discoveryc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "jwks":
+				endpoint = c.Jwks()
 			}
 		}
 	}
@@ -179,6 +416,200 @@ func ParseEndpoint(
 	return endpoint, data, nil
 }
 
+// adminUsage displays the usage of the admin command and its subcommands.
+func adminUsage() {
+	fmt.Fprintln(os.Stderr, `Service is the admin service interface.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] admin COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    list: List implements list.`)
+	fmt.Fprintln(os.Stderr, `    get: Get implements get.`)
+	fmt.Fprintln(os.Stderr, `    delete: Delete implements delete.`)
+	fmt.Fprintln(os.Stderr, `    disable: Disable implements disable.`)
+	fmt.Fprintln(os.Stderr, `    enable: Enable implements enable.`)
+	fmt.Fprintln(os.Stderr, `    reset-password: ResetPassword implements reset_password.`)
+	fmt.Fprintln(os.Stderr, `    unlock: Unlock implements unlock.`)
+	fmt.Fprintln(os.Stderr, `    set-email: SetEmail implements set_email.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s admin COMMAND --help\n", os.Args[0])
+}
+func adminListUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] admin list", os.Args[0])
+	fmt.Fprint(os.Stderr, " -after STRING")
+	fmt.Fprint(os.Stderr, " -search STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List implements list.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -after STRING: `)
+	fmt.Fprintln(os.Stderr, `    -search STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `admin list --after "Et dignissimos reprehenderit nihil." --search "Tenetur vel eveniet." --limit 174 --authorization "Sit quis."`)
+}
+
+func adminGetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] admin get", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get implements get.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -username STRING: The name of the user`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `admin get --username "Sed amet." --authorization "Dolorem officia."`)
+}
+
+func adminDeleteUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] admin delete", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete implements delete.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -username STRING: The name of the user`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `admin delete --username "Suscipit vero nihil." --authorization "Dolor molestiae est."`)
+}
+
+func adminDisableUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] admin disable", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Disable implements disable.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -username STRING: The name of the user`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `admin disable --username "Tempore dolores." --authorization "Veniam repellat dolore doloribus reiciendis eos."`)
+}
+
+func adminEnableUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] admin enable", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Enable implements enable.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -username STRING: The name of the user`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `admin enable --username "Ipsum voluptatem qui similique suscipit id necessitatibus." --authorization "Porro ea et molestiae."`)
+}
+
+func adminResetPasswordUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] admin reset-password", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `ResetPassword implements reset_password.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -username STRING: The name of the user`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `admin reset-password --username "Fugit nulla voluptatum soluta error." --authorization "Sed dolores illum."`)
+}
+
+func adminUnlockUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] admin unlock", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Unlock implements unlock.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -username STRING: The name of the user`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `admin unlock --username "Ab mollitia qui et ut tempore." --authorization "Iure fuga."`)
+}
+
+func adminSetEmailUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] admin set-email", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `SetEmail implements set_email.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -username STRING: The name of the user`)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `admin set-email --body '{
+      "email": "nasir_bartell@tremblay.org",
+      "verified": false
+   }' --username "Qui et consequatur inventore consequatur eveniet itaque." --authorization "Corrupti quibusdam corrupti tenetur ex."`)
+}
+
 // userUsage displays the usage of the user command and its subcommands.
 func userUsage() {
 	fmt.Fprintln(os.Stderr, `Service is the user service interface.`)
@@ -186,6 +617,13 @@ func userUsage() {
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    create: Create implements create.`)
 	fmt.Fprintln(os.Stderr, `    delete: Delete implements delete.`)
+	fmt.Fprintln(os.Stderr, `    get-me: GetMe implements get_me.`)
+	fmt.Fprintln(os.Stderr, `    update-me: UpdateMe implements update_me.`)
+	fmt.Fprintln(os.Stderr, `    change-password: ChangePassword implements change_password.`)
+	fmt.Fprintln(os.Stderr, `    request-password-reset: RequestPasswordReset implements request_password_reset.`)
+	fmt.Fprintln(os.Stderr, `    reset-password: ResetPassword implements reset_password.`)
+	fmt.Fprintln(os.Stderr, `    request-email-verification: RequestEmailVerification implements request_email_verification.`)
+	fmt.Fprintln(os.Stderr, `    verify-email: VerifyEmail implements verify_email.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s user COMMAND --help\n", os.Args[0])
@@ -207,8 +645,9 @@ func userCreateUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user create --body '{
-      "password": "Occaecati vel adipisci.",
-      "username": "Quia architecto veritatis deleniti ea perferendis."
+      "email": "eleonore@kilback.com",
+      "password": "A earum placeat sed sint.",
+      "username": "zgz"
    }'`)
 }
 
@@ -228,7 +667,165 @@ func userDeleteUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user delete --authorization "Voluptas explicabo et explicabo placeat."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user delete --authorization "Qui velit quod et voluptatum."`)
+}
+
+func userGetMeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] user get-me", os.Args[0])
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `GetMe implements get_me.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user get-me --authorization "Sit saepe."`)
+}
+
+func userUpdateMeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] user update-me", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `UpdateMe implements update_me.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user update-me --body '{
+      "attributes": {
+         "2lh": "ugx",
+         "qw9": "0r4",
+         "w": "3nk"
+      },
+      "display_name": "hzq",
+      "email": "holden_eichmann@powlowskihammes.net",
+      "locale": "tlg"
+   }' --authorization "Et officia."`)
+}
+
+func userChangePasswordUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] user change-password", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -authorization STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `ChangePassword implements change_password.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -authorization STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user change-password --body '{
+      "current_password": "Nemo ut.",
+      "new_password": "Dolorem animi nisi."
+   }' --authorization "Placeat voluptas."`)
+}
+
+func userRequestPasswordResetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] user request-password-reset", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `RequestPasswordReset implements request_password_reset.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user request-password-reset --body '{
+      "username": "8xf"
+   }'`)
+}
+
+func userResetPasswordUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] user reset-password", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `ResetPassword implements reset_password.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user reset-password --body '{
+      "new_password": "Eligendi sint corrupti.",
+      "token": "Quia nulla consectetur."
+   }'`)
+}
+
+func userRequestEmailVerificationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] user request-email-verification", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `RequestEmailVerification implements request_email_verification.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user request-email-verification --body '{
+      "username": "0xg"
+   }'`)
+}
+
+func userVerifyEmailUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] user verify-email", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `VerifyEmail implements verify_email.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `user verify-email --body '{
+      "token": "Neque quia error."
+   }'`)
 }
 
 // tokenUsage displays the usage of the token command and its subcommands.
@@ -238,6 +835,8 @@ func tokenUsage() {
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    issue: Issue implements issue.`)
 	fmt.Fprintln(os.Stderr, `    refresh: Refresh implements refresh.`)
+	fmt.Fprintln(os.Stderr, `    revoke: Revoke implements revoke.`)
+	fmt.Fprintln(os.Stderr, `    introspect: Introspect implements introspect.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s token COMMAND --help\n", os.Args[0])
@@ -259,8 +858,15 @@ func tokenIssueUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `token issue --body '{
-      "password": "Illo et rerum quia odit voluptas.",
-      "username": "Esse sapiente temporibus vel."
+      "audience": [
+         "Eligendi dolores incidunt magni eaque id.",
+         "Quibusdam earum deserunt."
+      ],
+      "client_id": "Non repellat pariatur facere non.",
+      "client_secret": "Natus unde qui rerum.",
+      "password": "Deleniti rerum qui recusandae dicta sed.",
+      "scope": "Ex aspernatur et est deserunt vitae.",
+      "username": "1mp"
    }'`)
 }
 
@@ -281,7 +887,87 @@ func tokenRefreshUsage() {
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `token refresh --body '{
-      "access_token": "Laboriosam exercitationem.",
-      "refresh_token": "Voluptatem sit culpa."
+      "access_token": "Quibusdam in non autem voluptatibus doloribus eligendi.",
+      "refresh_token": "Accusamus nam consectetur."
    }'`)
 }
+
+func tokenRevokeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] token revoke", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -client-id STRING")
+	fmt.Fprint(os.Stderr, " -client-secret STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Revoke implements revoke.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -client-id STRING: The ID of the client`)
+	fmt.Fprintln(os.Stderr, `    -client-secret STRING: The secret of the client`)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `token revoke --body '{
+      "token": "Natus quo qui error et voluptatem.",
+      "token_type_hint": "refresh_token"
+   }' --client-id "Dicta qui distinctio ea incidunt." --client-secret "Est rerum dolorem."`)
+}
+
+func tokenIntrospectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] token introspect", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -client-id STRING")
+	fmt.Fprint(os.Stderr, " -client-secret STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Introspect implements introspect.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -client-id STRING: The ID of the client`)
+	fmt.Fprintln(os.Stderr, `    -client-secret STRING: The secret of the client`)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `token introspect --body '{
+      "token": "Eos praesentium et.",
+      "token_type_hint": "refresh_token"
+   }' --client-id "Commodi commodi quia." --client-secret "Vel rerum."`)
+}
+
+// discoveryUsage displays the usage of the discovery command and its
+// subcommands.
+func discoveryUsage() {
+	fmt.Fprintln(os.Stderr, `Service is the discovery service interface.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] discovery COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    jwks: Jwks implements jwks.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s discovery COMMAND --help\n", os.Args[0])
+}
+func discoveryJwksUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] discovery jwks", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Jwks implements jwks.`)
+
+	// Flags list
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `discovery jwks`)
+}
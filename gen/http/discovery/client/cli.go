@@ -0,0 +1,8 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// discovery HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package client
@@ -0,0 +1,69 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// discovery client HTTP transport
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the discovery service endpoint HTTP clients.
+type Client struct {
+	// Jwks Doer is the HTTP client used to make requests to the jwks endpoint.
+	JwksDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the discovery service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		JwksDoer:            doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// Jwks returns an endpoint that makes HTTP requests to the discovery service
+// jwks server.
+func (c *Client) Jwks() goa.Endpoint {
+	var (
+		decodeResponse = DecodeJwksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildJwksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.JwksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("discovery", "jwks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,92 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// discovery HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	discovery "github.com/neatflowcv/key-stone/gen/discovery"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildJwksRequest instantiates a HTTP request object with method and path set
+// to call the "discovery" service "jwks" endpoint
+func (c *Client) BuildJwksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: JwksDiscoveryPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("discovery", "jwks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeJwksResponse returns a decoder for responses returned by the discovery
+// jwks endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeJwksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body JwksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("discovery", "jwks", err)
+			}
+			err = ValidateJwksResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("discovery", "jwks", err)
+			}
+			res := NewJwksJSONWebKeySetOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("discovery", "jwks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalJSONWebKeyResponseBodyToDiscoveryJSONWebKey builds a value of type
+// *discovery.JSONWebKey from a value of type *JSONWebKeyResponseBody.
+func unmarshalJSONWebKeyResponseBodyToDiscoveryJSONWebKey(v *JSONWebKeyResponseBody) *discovery.JSONWebKey {
+	res := &discovery.JSONWebKey{
+		Kty: *v.Kty,
+		Use: v.Use,
+		Alg: v.Alg,
+		Kid: v.Kid,
+		N:   v.N,
+		E:   v.E,
+		Crv: v.Crv,
+		X:   v.X,
+		Y:   v.Y,
+	}
+
+	return res
+}
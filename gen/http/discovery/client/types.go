@@ -0,0 +1,78 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// discovery HTTP client types
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package client
+
+import (
+	discovery "github.com/neatflowcv/key-stone/gen/discovery"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// JwksResponseBody is the type of the "discovery" service "jwks" endpoint HTTP
+// response body.
+type JwksResponseBody struct {
+	// The keys that verify access tokens
+	Keys []*JSONWebKeyResponseBody `form:"keys,omitempty" json:"keys,omitempty" xml:"keys,omitempty"`
+}
+
+// JSONWebKeyResponseBody is used to define fields on response body types.
+type JSONWebKeyResponseBody struct {
+	// The key type
+	Kty *string `form:"kty,omitempty" json:"kty,omitempty" xml:"kty,omitempty"`
+	// The intended use of the key
+	Use *string `form:"use,omitempty" json:"use,omitempty" xml:"use,omitempty"`
+	// The algorithm the key is used with
+	Alg *string `form:"alg,omitempty" json:"alg,omitempty" xml:"alg,omitempty"`
+	// The key ID
+	Kid *string `form:"kid,omitempty" json:"kid,omitempty" xml:"kid,omitempty"`
+	// The RSA modulus
+	N *string `form:"n,omitempty" json:"n,omitempty" xml:"n,omitempty"`
+	// The RSA public exponent
+	E *string `form:"e,omitempty" json:"e,omitempty" xml:"e,omitempty"`
+	// The curve of the EC or OKP key
+	Crv *string `form:"crv,omitempty" json:"crv,omitempty" xml:"crv,omitempty"`
+	// The x coordinate of the EC key or the OKP public key
+	X *string `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	// The y coordinate of the EC key
+	Y *string `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+}
+
+// NewJwksJSONWebKeySetOK builds a "discovery" service "jwks" endpoint result
+// from a HTTP "OK" response.
+func NewJwksJSONWebKeySetOK(body *JwksResponseBody) *discovery.JSONWebKeySet {
+	v := &discovery.JSONWebKeySet{}
+	v.Keys = make([]*discovery.JSONWebKey, len(body.Keys))
+	for i, val := range body.Keys {
+		v.Keys[i] = unmarshalJSONWebKeyResponseBodyToDiscoveryJSONWebKey(val)
+	}
+
+	return v
+}
+
+// ValidateJwksResponseBody runs the validations defined on JwksResponseBody
+func ValidateJwksResponseBody(body *JwksResponseBody) (err error) {
+	if body.Keys == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keys", "body"))
+	}
+	for _, e := range body.Keys {
+		if e != nil {
+			if err2 := ValidateJSONWebKeyResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateJSONWebKeyResponseBody runs the validations defined on
+// JSONWebKeyResponseBody
+func ValidateJSONWebKeyResponseBody(body *JSONWebKeyResponseBody) (err error) {
+	if body.Kty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kty", "body"))
+	}
+	return
+}
@@ -0,0 +1,46 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// discovery HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	discovery "github.com/neatflowcv/key-stone/gen/discovery"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// EncodeJwksResponse returns an encoder for responses returned by the
+// discovery jwks endpoint.
+func EncodeJwksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*discovery.JSONWebKeySet)
+		enc := encoder(ctx, w)
+		body := NewJwksResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// marshalDiscoveryJSONWebKeyToJSONWebKeyResponseBody builds a value of type
+// *JSONWebKeyResponseBody from a value of type *discovery.JSONWebKey.
+func marshalDiscoveryJSONWebKeyToJSONWebKeyResponseBody(v *discovery.JSONWebKey) *JSONWebKeyResponseBody {
+	res := &JSONWebKeyResponseBody{
+		Kty: v.Kty,
+		Use: v.Use,
+		Alg: v.Alg,
+		Kid: v.Kid,
+		N:   v.N,
+		E:   v.E,
+		Crv: v.Crv,
+		X:   v.X,
+		Y:   v.Y,
+	}
+
+	return res
+}
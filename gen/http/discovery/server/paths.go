@@ -0,0 +1,13 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// HTTP request path constructors for the discovery service.
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package server
+
+// JwksDiscoveryPath returns the URL path to the discovery service jwks HTTP endpoint.
+func JwksDiscoveryPath() string {
+	return "/key-stone/.well-known/jwks.json"
+}
@@ -0,0 +1,56 @@
+// Code generated by goa v3.22.5, DO NOT EDIT.
+//
+// discovery HTTP server types
+//
+// Command:
+// $ goa gen github.com/neatflowcv/key-stone/design
+
+package server
+
+import (
+	discovery "github.com/neatflowcv/key-stone/gen/discovery"
+)
+
+// JwksResponseBody is the type of the "discovery" service "jwks" endpoint HTTP
+// response body.
+type JwksResponseBody struct {
+	// The keys that verify access tokens
+	Keys []*JSONWebKeyResponseBody `form:"keys" json:"keys" xml:"keys"`
+}
+
+// JSONWebKeyResponseBody is used to define fields on response body types.
+type JSONWebKeyResponseBody struct {
+	// The key type
+	Kty string `form:"kty" json:"kty" xml:"kty"`
+	// The intended use of the key
+	Use *string `form:"use,omitempty" json:"use,omitempty" xml:"use,omitempty"`
+	// The algorithm the key is used with
+	Alg *string `form:"alg,omitempty" json:"alg,omitempty" xml:"alg,omitempty"`
+	// The key ID
+	Kid *string `form:"kid,omitempty" json:"kid,omitempty" xml:"kid,omitempty"`
+	// The RSA modulus
+	N *string `form:"n,omitempty" json:"n,omitempty" xml:"n,omitempty"`
+	// The RSA public exponent
+	E *string `form:"e,omitempty" json:"e,omitempty" xml:"e,omitempty"`
+	// The curve of the EC or OKP key
+	Crv *string `form:"crv,omitempty" json:"crv,omitempty" xml:"crv,omitempty"`
+	// The x coordinate of the EC key or the OKP public key
+	X *string `form:"x,omitempty" json:"x,omitempty" xml:"x,omitempty"`
+	// The y coordinate of the EC key
+	Y *string `form:"y,omitempty" json:"y,omitempty" xml:"y,omitempty"`
+}
+
+// NewJwksResponseBody builds the HTTP response body from the result of the
+// "jwks" endpoint of the "discovery" service.
+func NewJwksResponseBody(res *discovery.JSONWebKeySet) *JwksResponseBody {
+	body := &JwksResponseBody{}
+	if res.Keys != nil {
+		body.Keys = make([]*JSONWebKeyResponseBody, len(res.Keys))
+		for i, val := range res.Keys {
+			body.Keys[i] = marshalDiscoveryJSONWebKeyToJSONWebKeyResponseBody(val)
+		}
+	} else {
+		body.Keys = []*JSONWebKeyResponseBody{}
+	}
+	return body
+}
@@ -10,8 +10,10 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"unicode/utf8"
 
 	token "github.com/neatflowcv/key-stone/gen/token"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildIssuePayload builds the payload for the token issue endpoint from CLI
@@ -22,12 +24,27 @@ func BuildIssuePayload(tokenIssueBody string) (*token.IssueInput, error) {
 	{
 		err = json.Unmarshal([]byte(tokenIssueBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"password\": \"Illo et rerum quia odit voluptas.\",\n      \"username\": \"Esse sapiente temporibus vel.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": [\n         \"Illo veritatis et officia voluptas.\",\n         \"Ut sit provident aut nisi quam facilis.\",\n         \"Iusto quia repellendus iste rerum et a.\",\n         \"Nostrum aspernatur aut dicta.\"\n      ],\n      \"client_id\": \"Culpa porro ea est consequuntur est.\",\n      \"client_secret\": \"Aut culpa aspernatur ea autem aliquid non.\",\n      \"password\": \"Consequatur consequuntur et deserunt aspernatur est facilis.\",\n      \"scope\": \"Vel eligendi id dolor quaerat et minus.\",\n      \"username\": \"cn4\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Username) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", body.Username, utf8.RuneCountInString(body.Username), 256, false))
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 	v := &token.IssueInput{
-		Username: body.Username,
-		Password: body.Password,
+		Username:     body.Username,
+		Password:     body.Password,
+		ClientID:     body.ClientID,
+		ClientSecret: body.ClientSecret,
+		Scope:        body.Scope,
+	}
+	if body.Audience != nil {
+		v.Audience = make([]string, len(body.Audience))
+		for i, val := range body.Audience {
+			v.Audience[i] = val
+		}
 	}
 
 	return v, nil
@@ -41,7 +58,7 @@ func BuildRefreshPayload(tokenRefreshBody string) (*token.RefreshInput, error) {
 	{
 		err = json.Unmarshal([]byte(tokenRefreshBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"access_token\": \"Laboriosam exercitationem.\",\n      \"refresh_token\": \"Voluptatem sit culpa.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"access_token\": \"Doloribus iste assumenda placeat tempore sunt neque.\",\n      \"refresh_token\": \"Quisquam dolorem aut.\"\n   }'")
 		}
 	}
 	v := &token.RefreshInput{
@@ -51,3 +68,77 @@ func BuildRefreshPayload(tokenRefreshBody string) (*token.RefreshInput, error) {
 
 	return v, nil
 }
+
+// BuildRevokePayload builds the payload for the token revoke endpoint from CLI
+// flags.
+func BuildRevokePayload(tokenRevokeBody string, tokenRevokeClientID string, tokenRevokeClientSecret string) (*token.RevokeInput, error) {
+	var err error
+	var body RevokeRequestBody
+	{
+		err = json.Unmarshal([]byte(tokenRevokeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Ab soluta placeat quo odio.\",\n      \"token_type_hint\": \"access_token\"\n   }'")
+		}
+		if body.TokenTypeHint != nil {
+			if !(*body.TokenTypeHint == "access_token" || *body.TokenTypeHint == "refresh_token") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_type_hint", *body.TokenTypeHint, []any{"access_token", "refresh_token"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var client_id string
+	{
+		client_id = tokenRevokeClientID
+	}
+	var client_secret string
+	{
+		client_secret = tokenRevokeClientSecret
+	}
+	v := &token.RevokeInput{
+		Token:         body.Token,
+		TokenTypeHint: body.TokenTypeHint,
+	}
+	v.ClientID = client_id
+	v.ClientSecret = client_secret
+
+	return v, nil
+}
+
+// BuildIntrospectPayload builds the payload for the token introspect endpoint
+// from CLI flags.
+func BuildIntrospectPayload(tokenIntrospectBody string, tokenIntrospectClientID string, tokenIntrospectClientSecret string) (*token.IntrospectInput, error) {
+	var err error
+	var body IntrospectRequestBody
+	{
+		err = json.Unmarshal([]byte(tokenIntrospectBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Culpa itaque repellat nam.\",\n      \"token_type_hint\": \"refresh_token\"\n   }'")
+		}
+		if body.TokenTypeHint != nil {
+			if !(*body.TokenTypeHint == "access_token" || *body.TokenTypeHint == "refresh_token") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_type_hint", *body.TokenTypeHint, []any{"access_token", "refresh_token"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var client_id string
+	{
+		client_id = tokenIntrospectClientID
+	}
+	var client_secret string
+	{
+		client_secret = tokenIntrospectClientSecret
+	}
+	v := &token.IntrospectInput{
+		Token:         body.Token,
+		TokenTypeHint: body.TokenTypeHint,
+	}
+	v.ClientID = client_id
+	v.ClientSecret = client_secret
+
+	return v, nil
+}
@@ -24,6 +24,13 @@ type Client struct {
 	// endpoint.
 	RefreshDoer goahttp.Doer
 
+	// Revoke Doer is the HTTP client used to make requests to the revoke endpoint.
+	RevokeDoer goahttp.Doer
+
+	// Introspect Doer is the HTTP client used to make requests to the introspect
+	// endpoint.
+	IntrospectDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -46,6 +53,8 @@ func NewClient(
 	return &Client{
 		IssueDoer:           doer,
 		RefreshDoer:         doer,
+		RevokeDoer:          doer,
+		IntrospectDoer:      doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -101,3 +110,51 @@ func (c *Client) Refresh() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Revoke returns an endpoint that makes HTTP requests to the token service
+// revoke server.
+func (c *Client) Revoke() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeRequest(c.encoder)
+		decodeResponse = DecodeRevokeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("token", "revoke", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Introspect returns an endpoint that makes HTTP requests to the token service
+// introspect server.
+func (c *Client) Introspect() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeIntrospectRequest(c.encoder)
+		decodeResponse = DecodeIntrospectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildIntrospectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.IntrospectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("token", "introspect", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -53,6 +53,7 @@ func EncodeIssueRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.
 // issue endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
 // DecodeIssueResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
 //   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeIssueResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
@@ -85,6 +86,20 @@ func DecodeIssueResponse(decoder func(*http.Response) goahttp.Decoder, restoreBo
 			}
 			res := NewIssueTokenDetailOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body IssueBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("token", "issue", err)
+			}
+			err = ValidateIssueBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("token", "issue", err)
+			}
+			return nil, NewIssueBadRequest(&body)
 		case http.StatusUnauthorized:
 			var (
 				body IssueUnauthorizedResponseBody
@@ -208,3 +223,198 @@ func DecodeRefreshResponse(decoder func(*http.Response) goahttp.Decoder, restore
 		}
 	}
 }
+
+// BuildRevokeRequest instantiates a HTTP request object with method and path
+// set to call the "token" service "revoke" endpoint
+func (c *Client) BuildRevokeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeTokenPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("token", "revoke", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeRequest returns an encoder for requests sent to the token revoke
+// server.
+func EncodeRevokeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*token.RevokeInput)
+		if !ok {
+			return goahttp.ErrInvalidType("token", "revoke", "*token.RevokeInput", v)
+		}
+		body := NewRevokeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("token", "revoke", err)
+		}
+		req.SetBasicAuth(p.ClientID, p.ClientSecret)
+		return nil
+	}
+}
+
+// DecodeRevokeResponse returns a decoder for responses returned by the token
+// revoke endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeRevokeResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRevokeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body RevokeUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("token", "revoke", err)
+			}
+			err = ValidateRevokeUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("token", "revoke", err)
+			}
+			return nil, NewRevokeUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RevokeInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("token", "revoke", err)
+			}
+			err = ValidateRevokeInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("token", "revoke", err)
+			}
+			return nil, NewRevokeInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("token", "revoke", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildIntrospectRequest instantiates a HTTP request object with method and
+// path set to call the "token" service "introspect" endpoint
+func (c *Client) BuildIntrospectRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: IntrospectTokenPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("token", "introspect", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeIntrospectRequest returns an encoder for requests sent to the token
+// introspect server.
+func EncodeIntrospectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*token.IntrospectInput)
+		if !ok {
+			return goahttp.ErrInvalidType("token", "introspect", "*token.IntrospectInput", v)
+		}
+		body := NewIntrospectRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("token", "introspect", err)
+		}
+		req.SetBasicAuth(p.ClientID, p.ClientSecret)
+		return nil
+	}
+}
+
+// DecodeIntrospectResponse returns a decoder for responses returned by the
+// token introspect endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeIntrospectResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeIntrospectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body IntrospectResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("token", "introspect", err)
+			}
+			err = ValidateIntrospectResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("token", "introspect", err)
+			}
+			res := NewIntrospectionResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body IntrospectUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("token", "introspect", err)
+			}
+			err = ValidateIntrospectUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("token", "introspect", err)
+			}
+			return nil, NewIntrospectUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body IntrospectInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("token", "introspect", err)
+			}
+			err = ValidateIntrospectInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("token", "introspect", err)
+			}
+			return nil, NewIntrospectInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("token", "introspect", resp.StatusCode, string(body))
+		}
+	}
+}
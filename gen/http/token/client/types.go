@@ -19,17 +19,43 @@ type IssueRequestBody struct {
 	Username string `form:"username" json:"username" xml:"username"`
 	// The password of the user
 	Password string `form:"password" json:"password" xml:"password"`
+	// The client the user logs in with, which may have its own token policy
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+	// The secret of the client
+	ClientSecret *string `form:"client_secret,omitempty" json:"client_secret,omitempty" xml:"client_secret,omitempty"`
+	// The services the access token is meant for, key-stone itself if omitted
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+	// The space separated scopes requested, every granted scope if omitted
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
 }
 
 // RefreshRequestBody is the type of the "token" service "refresh" endpoint
 // HTTP request body.
 type RefreshRequestBody struct {
-	// The access token of the user
-	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// The access token of the same session, may be expired
+	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
 	// The refresh token of the user
 	RefreshToken string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
 }
 
+// RevokeRequestBody is the type of the "token" service "revoke" endpoint HTTP
+// request body.
+type RevokeRequestBody struct {
+	// The access or refresh token to revoke
+	Token string `form:"token" json:"token" xml:"token"`
+	// The kind of the token
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
+}
+
+// IntrospectRequestBody is the type of the "token" service "introspect"
+// endpoint HTTP request body.
+type IntrospectRequestBody struct {
+	// The access or refresh token to introspect
+	Token string `form:"token" json:"token" xml:"token"`
+	// The kind of the token
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
+}
+
 // IssueResponseBody is the type of the "token" service "issue" endpoint HTTP
 // response body.
 type IssueResponseBody struct {
@@ -41,6 +67,8 @@ type IssueResponseBody struct {
 	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
 	// The refresh token of the user
 	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// The space separated scopes granted to the access token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
 }
 
 // RefreshResponseBody is the type of the "token" service "refresh" endpoint
@@ -54,6 +82,53 @@ type RefreshResponseBody struct {
 	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
 	// The refresh token of the user
 	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
+	// The space separated scopes granted to the access token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// IntrospectResponseBody is the type of the "token" service "introspect"
+// endpoint HTTP response body.
+type IntrospectResponseBody struct {
+	// Whether the token is currently active
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// The type of an access token, Bearer. Omitted for refresh tokens
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// The ID of the token
+	Jti *string `form:"jti,omitempty" json:"jti,omitempty" xml:"jti,omitempty"`
+	// The subject of the token
+	Sub *string `form:"sub,omitempty" json:"sub,omitempty" xml:"sub,omitempty"`
+	// The issuer of the token
+	Iss *string `form:"iss,omitempty" json:"iss,omitempty" xml:"iss,omitempty"`
+	// The audience of the token
+	Aud []string `form:"aud,omitempty" json:"aud,omitempty" xml:"aud,omitempty"`
+	// The space separated scopes of the token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// The client the token was issued to
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+	// The time the token was issued at, in seconds since the epoch
+	Iat *int64 `form:"iat,omitempty" json:"iat,omitempty" xml:"iat,omitempty"`
+	// The time the token expires at, in seconds since the epoch
+	Exp *int64 `form:"exp,omitempty" json:"exp,omitempty" xml:"exp,omitempty"`
+	// The custom claims of the token
+	Ext map[string]any `form:"ext,omitempty" json:"ext,omitempty" xml:"ext,omitempty"`
+}
+
+// IssueBadRequestResponseBody is the type of the "token" service "issue"
+// endpoint HTTP response body for the "BadRequest" error.
+type IssueBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
 // IssueUnauthorizedResponseBody is the type of the "token" service "issue"
@@ -110,12 +185,93 @@ type RefreshInternalServerErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// RevokeUnauthorizedResponseBody is the type of the "token" service "revoke"
+// endpoint HTTP response body for the "Unauthorized" error.
+type RevokeUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeInternalServerErrorResponseBody is the type of the "token" service
+// "revoke" endpoint HTTP response body for the "InternalServerError" error.
+type RevokeInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// IntrospectUnauthorizedResponseBody is the type of the "token" service
+// "introspect" endpoint HTTP response body for the "Unauthorized" error.
+type IntrospectUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// IntrospectInternalServerErrorResponseBody is the type of the "token" service
+// "introspect" endpoint HTTP response body for the "InternalServerError" error.
+type IntrospectInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // NewIssueRequestBody builds the HTTP request body from the payload of the
 // "issue" endpoint of the "token" service.
 func NewIssueRequestBody(p *token.IssueInput) *IssueRequestBody {
 	body := &IssueRequestBody{
-		Username: p.Username,
-		Password: p.Password,
+		Username:     p.Username,
+		Password:     p.Password,
+		ClientID:     p.ClientID,
+		ClientSecret: p.ClientSecret,
+		Scope:        p.Scope,
+	}
+	if p.Audience != nil {
+		body.Audience = make([]string, len(p.Audience))
+		for i, val := range p.Audience {
+			body.Audience[i] = val
+		}
 	}
 	return body
 }
@@ -130,6 +286,26 @@ func NewRefreshRequestBody(p *token.RefreshInput) *RefreshRequestBody {
 	return body
 }
 
+// NewRevokeRequestBody builds the HTTP request body from the payload of the
+// "revoke" endpoint of the "token" service.
+func NewRevokeRequestBody(p *token.RevokeInput) *RevokeRequestBody {
+	body := &RevokeRequestBody{
+		Token:         p.Token,
+		TokenTypeHint: p.TokenTypeHint,
+	}
+	return body
+}
+
+// NewIntrospectRequestBody builds the HTTP request body from the payload of
+// the "introspect" endpoint of the "token" service.
+func NewIntrospectRequestBody(p *token.IntrospectInput) *IntrospectRequestBody {
+	body := &IntrospectRequestBody{
+		Token:         p.Token,
+		TokenTypeHint: p.TokenTypeHint,
+	}
+	return body
+}
+
 // NewIssueTokenDetailOK builds a "token" service "issue" endpoint result from
 // a HTTP "OK" response.
 func NewIssueTokenDetailOK(body *IssueResponseBody) *token.TokenDetail {
@@ -138,6 +314,21 @@ func NewIssueTokenDetailOK(body *IssueResponseBody) *token.TokenDetail {
 		TokenType:    *body.TokenType,
 		ExpiresIn:    *body.ExpiresIn,
 		RefreshToken: *body.RefreshToken,
+		Scope:        body.Scope,
+	}
+
+	return v
+}
+
+// NewIssueBadRequest builds a token service issue endpoint BadRequest error.
+func NewIssueBadRequest(body *IssueBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
 
 	return v
@@ -166,6 +357,7 @@ func NewRefreshTokenDetailOK(body *RefreshResponseBody) *token.TokenDetail {
 		TokenType:    *body.TokenType,
 		ExpiresIn:    *body.ExpiresIn,
 		RefreshToken: *body.RefreshToken,
+		Scope:        body.Scope,
 	}
 
 	return v
@@ -201,6 +393,98 @@ func NewRefreshInternalServerError(body *RefreshInternalServerErrorResponseBody)
 	return v
 }
 
+// NewRevokeUnauthorized builds a token service revoke endpoint Unauthorized
+// error.
+func NewRevokeUnauthorized(body *RevokeUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeInternalServerError builds a token service revoke endpoint
+// InternalServerError error.
+func NewRevokeInternalServerError(body *RevokeInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewIntrospectionResultOK builds a "token" service "introspect" endpoint
+// result from a HTTP "OK" response.
+func NewIntrospectionResultOK(body *IntrospectResponseBody) *token.IntrospectionResult {
+	v := &token.IntrospectionResult{
+		Active:    *body.Active,
+		TokenType: body.TokenType,
+		Jti:       body.Jti,
+		Sub:       body.Sub,
+		Iss:       body.Iss,
+		Scope:     body.Scope,
+		ClientID:  body.ClientID,
+		Iat:       body.Iat,
+		Exp:       body.Exp,
+	}
+	if body.Aud != nil {
+		v.Aud = make([]string, len(body.Aud))
+		for i, val := range body.Aud {
+			v.Aud[i] = val
+		}
+	}
+	if body.Ext != nil {
+		v.Ext = make(map[string]any, len(body.Ext))
+		for key, val := range body.Ext {
+			tk := key
+			tv := val
+			v.Ext[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewIntrospectUnauthorized builds a token service introspect endpoint
+// Unauthorized error.
+func NewIntrospectUnauthorized(body *IntrospectUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewIntrospectInternalServerError builds a token service introspect endpoint
+// InternalServerError error.
+func NewIntrospectInternalServerError(body *IntrospectInternalServerErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
 // ValidateIssueResponseBody runs the validations defined on IssueResponseBody
 func ValidateIssueResponseBody(body *IssueResponseBody) (err error) {
 	if body.AccessToken == nil {
@@ -236,6 +520,39 @@ func ValidateRefreshResponseBody(body *RefreshResponseBody) (err error) {
 	return
 }
 
+// ValidateIntrospectResponseBody runs the validations defined on
+// IntrospectResponseBody
+func ValidateIntrospectResponseBody(body *IntrospectResponseBody) (err error) {
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	return
+}
+
+// ValidateIssueBadRequestResponseBody runs the validations defined on
+// issue_BadRequest_response_body
+func ValidateIssueBadRequestResponseBody(body *IssueBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateIssueUnauthorizedResponseBody runs the validations defined on
 // issue_Unauthorized_response_body
 func ValidateIssueUnauthorizedResponseBody(body *IssueUnauthorizedResponseBody) (err error) {
@@ -307,3 +624,99 @@ func ValidateRefreshInternalServerErrorResponseBody(body *RefreshInternalServerE
 	}
 	return
 }
+
+// ValidateRevokeUnauthorizedResponseBody runs the validations defined on
+// revoke_Unauthorized_response_body
+func ValidateRevokeUnauthorizedResponseBody(body *RevokeUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeInternalServerErrorResponseBody runs the validations defined
+// on revoke_InternalServerError_response_body
+func ValidateRevokeInternalServerErrorResponseBody(body *RevokeInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateIntrospectUnauthorizedResponseBody runs the validations defined on
+// introspect_Unauthorized_response_body
+func ValidateIntrospectUnauthorizedResponseBody(body *IntrospectUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateIntrospectInternalServerErrorResponseBody runs the validations
+// defined on introspect_InternalServerError_response_body
+func ValidateIntrospectInternalServerErrorResponseBody(body *IntrospectInternalServerErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
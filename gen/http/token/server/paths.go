@@ -16,3 +16,13 @@ func IssueTokenPath() string {
 func RefreshTokenPath() string {
 	return "/key-stone/auth/refresh"
 }
+
+// RevokeTokenPath returns the URL path to the token service revoke HTTP endpoint.
+func RevokeTokenPath() string {
+	return "/key-stone/auth/revoke"
+}
+
+// IntrospectTokenPath returns the URL path to the token service introspect HTTP endpoint.
+func IntrospectTokenPath() string {
+	return "/key-stone/auth/introspect"
+}
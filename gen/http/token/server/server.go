@@ -18,9 +18,11 @@ import (
 
 // Server lists the token service endpoint HTTP handlers.
 type Server struct {
-	Mounts  []*MountPoint
-	Issue   http.Handler
-	Refresh http.Handler
+	Mounts     []*MountPoint
+	Issue      http.Handler
+	Refresh    http.Handler
+	Revoke     http.Handler
+	Introspect http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -52,9 +54,13 @@ func New(
 		Mounts: []*MountPoint{
 			{"Issue", "POST", "/key-stone/auth"},
 			{"Refresh", "POST", "/key-stone/auth/refresh"},
+			{"Revoke", "POST", "/key-stone/auth/revoke"},
+			{"Introspect", "POST", "/key-stone/auth/introspect"},
 		},
-		Issue:   NewIssueHandler(e.Issue, mux, decoder, encoder, errhandler, formatter),
-		Refresh: NewRefreshHandler(e.Refresh, mux, decoder, encoder, errhandler, formatter),
+		Issue:      NewIssueHandler(e.Issue, mux, decoder, encoder, errhandler, formatter),
+		Refresh:    NewRefreshHandler(e.Refresh, mux, decoder, encoder, errhandler, formatter),
+		Revoke:     NewRevokeHandler(e.Revoke, mux, decoder, encoder, errhandler, formatter),
+		Introspect: NewIntrospectHandler(e.Introspect, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -65,6 +71,8 @@ func (s *Server) Service() string { return "token" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Issue = m(s.Issue)
 	s.Refresh = m(s.Refresh)
+	s.Revoke = m(s.Revoke)
+	s.Introspect = m(s.Introspect)
 }
 
 // MethodNames returns the methods served.
@@ -74,6 +82,8 @@ func (s *Server) MethodNames() []string { return token.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountIssueHandler(mux, h.Issue)
 	MountRefreshHandler(mux, h.Refresh)
+	MountRevokeHandler(mux, h.Revoke)
+	MountIntrospectHandler(mux, h.Introspect)
 }
 
 // Mount configures the mux to serve the token endpoints.
@@ -186,3 +196,109 @@ func NewRefreshHandler(
 		}
 	})
 }
+
+// MountRevokeHandler configures the mux to serve the "token" service "revoke"
+// endpoint.
+func MountRevokeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/key-stone/auth/revoke", f)
+}
+
+// NewRevokeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "token" service "revoke" endpoint.
+func NewRevokeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeRequest(mux, decoder)
+		encodeResponse = EncodeRevokeResponse(encoder)
+		encodeError    = EncodeRevokeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revoke")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "token")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountIntrospectHandler configures the mux to serve the "token" service
+// "introspect" endpoint.
+func MountIntrospectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/key-stone/auth/introspect", f)
+}
+
+// NewIntrospectHandler creates a HTTP handler which loads the HTTP request and
+// calls the "token" service "introspect" endpoint.
+func NewIntrospectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeIntrospectRequest(mux, decoder)
+		encodeResponse = EncodeIntrospectResponse(encoder)
+		encodeError    = EncodeIntrospectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "introspect")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "token")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
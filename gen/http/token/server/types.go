@@ -8,6 +8,8 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	token "github.com/neatflowcv/key-stone/gen/token"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -19,17 +21,43 @@ type IssueRequestBody struct {
 	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 	// The password of the user
 	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// The client the user logs in with, which may have its own token policy
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+	// The secret of the client
+	ClientSecret *string `form:"client_secret,omitempty" json:"client_secret,omitempty" xml:"client_secret,omitempty"`
+	// The services the access token is meant for, key-stone itself if omitted
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+	// The space separated scopes requested, every granted scope if omitted
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
 }
 
 // RefreshRequestBody is the type of the "token" service "refresh" endpoint
 // HTTP request body.
 type RefreshRequestBody struct {
-	// The access token of the user
+	// The access token of the same session, may be expired
 	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
 	// The refresh token of the user
 	RefreshToken *string `form:"refresh_token,omitempty" json:"refresh_token,omitempty" xml:"refresh_token,omitempty"`
 }
 
+// RevokeRequestBody is the type of the "token" service "revoke" endpoint HTTP
+// request body.
+type RevokeRequestBody struct {
+	// The access or refresh token to revoke
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// The kind of the token
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
+}
+
+// IntrospectRequestBody is the type of the "token" service "introspect"
+// endpoint HTTP request body.
+type IntrospectRequestBody struct {
+	// The access or refresh token to introspect
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// The kind of the token
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty" xml:"token_type_hint,omitempty"`
+}
+
 // IssueResponseBody is the type of the "token" service "issue" endpoint HTTP
 // response body.
 type IssueResponseBody struct {
@@ -41,6 +69,8 @@ type IssueResponseBody struct {
 	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
 	// The refresh token of the user
 	RefreshToken string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
+	// The space separated scopes granted to the access token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
 }
 
 // RefreshResponseBody is the type of the "token" service "refresh" endpoint
@@ -54,6 +84,53 @@ type RefreshResponseBody struct {
 	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
 	// The refresh token of the user
 	RefreshToken string `form:"refresh_token" json:"refresh_token" xml:"refresh_token"`
+	// The space separated scopes granted to the access token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// IntrospectResponseBody is the type of the "token" service "introspect"
+// endpoint HTTP response body.
+type IntrospectResponseBody struct {
+	// Whether the token is currently active
+	Active bool `form:"active" json:"active" xml:"active"`
+	// The type of an access token, Bearer. Omitted for refresh tokens
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// The ID of the token
+	Jti *string `form:"jti,omitempty" json:"jti,omitempty" xml:"jti,omitempty"`
+	// The subject of the token
+	Sub *string `form:"sub,omitempty" json:"sub,omitempty" xml:"sub,omitempty"`
+	// The issuer of the token
+	Iss *string `form:"iss,omitempty" json:"iss,omitempty" xml:"iss,omitempty"`
+	// The audience of the token
+	Aud []string `form:"aud,omitempty" json:"aud,omitempty" xml:"aud,omitempty"`
+	// The space separated scopes of the token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// The client the token was issued to
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+	// The time the token was issued at, in seconds since the epoch
+	Iat *int64 `form:"iat,omitempty" json:"iat,omitempty" xml:"iat,omitempty"`
+	// The time the token expires at, in seconds since the epoch
+	Exp *int64 `form:"exp,omitempty" json:"exp,omitempty" xml:"exp,omitempty"`
+	// The custom claims of the token
+	Ext map[string]any `form:"ext,omitempty" json:"ext,omitempty" xml:"ext,omitempty"`
+}
+
+// IssueBadRequestResponseBody is the type of the "token" service "issue"
+// endpoint HTTP response body for the "BadRequest" error.
+type IssueBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
 // IssueUnauthorizedResponseBody is the type of the "token" service "issue"
@@ -110,6 +187,78 @@ type RefreshInternalServerErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// RevokeUnauthorizedResponseBody is the type of the "token" service "revoke"
+// endpoint HTTP response body for the "Unauthorized" error.
+type RevokeUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeInternalServerErrorResponseBody is the type of the "token" service
+// "revoke" endpoint HTTP response body for the "InternalServerError" error.
+type RevokeInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// IntrospectUnauthorizedResponseBody is the type of the "token" service
+// "introspect" endpoint HTTP response body for the "Unauthorized" error.
+type IntrospectUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// IntrospectInternalServerErrorResponseBody is the type of the "token" service
+// "introspect" endpoint HTTP response body for the "InternalServerError" error.
+type IntrospectInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // NewIssueResponseBody builds the HTTP response body from the result of the
 // "issue" endpoint of the "token" service.
 func NewIssueResponseBody(res *token.TokenDetail) *IssueResponseBody {
@@ -118,6 +267,7 @@ func NewIssueResponseBody(res *token.TokenDetail) *IssueResponseBody {
 		TokenType:    res.TokenType,
 		ExpiresIn:    res.ExpiresIn,
 		RefreshToken: res.RefreshToken,
+		Scope:        res.Scope,
 	}
 	return body
 }
@@ -130,6 +280,52 @@ func NewRefreshResponseBody(res *token.TokenDetail) *RefreshResponseBody {
 		TokenType:    res.TokenType,
 		ExpiresIn:    res.ExpiresIn,
 		RefreshToken: res.RefreshToken,
+		Scope:        res.Scope,
+	}
+	return body
+}
+
+// NewIntrospectResponseBody builds the HTTP response body from the result of
+// the "introspect" endpoint of the "token" service.
+func NewIntrospectResponseBody(res *token.IntrospectionResult) *IntrospectResponseBody {
+	body := &IntrospectResponseBody{
+		Active:    res.Active,
+		TokenType: res.TokenType,
+		Jti:       res.Jti,
+		Sub:       res.Sub,
+		Iss:       res.Iss,
+		Scope:     res.Scope,
+		ClientID:  res.ClientID,
+		Iat:       res.Iat,
+		Exp:       res.Exp,
+	}
+	if res.Aud != nil {
+		body.Aud = make([]string, len(res.Aud))
+		for i, val := range res.Aud {
+			body.Aud[i] = val
+		}
+	}
+	if res.Ext != nil {
+		body.Ext = make(map[string]any, len(res.Ext))
+		for key, val := range res.Ext {
+			tk := key
+			tv := val
+			body.Ext[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewIssueBadRequestResponseBody builds the HTTP response body from the result
+// of the "issue" endpoint of the "token" service.
+func NewIssueBadRequestResponseBody(res *goa.ServiceError) *IssueBadRequestResponseBody {
+	body := &IssueBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
@@ -176,11 +372,76 @@ func NewRefreshInternalServerErrorResponseBody(res *goa.ServiceError) *RefreshIn
 	return body
 }
 
+// NewRevokeUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "revoke" endpoint of the "token" service.
+func NewRevokeUnauthorizedResponseBody(res *goa.ServiceError) *RevokeUnauthorizedResponseBody {
+	body := &RevokeUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "revoke" endpoint of the "token" service.
+func NewRevokeInternalServerErrorResponseBody(res *goa.ServiceError) *RevokeInternalServerErrorResponseBody {
+	body := &RevokeInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewIntrospectUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "introspect" endpoint of the "token" service.
+func NewIntrospectUnauthorizedResponseBody(res *goa.ServiceError) *IntrospectUnauthorizedResponseBody {
+	body := &IntrospectUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewIntrospectInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "introspect" endpoint of the "token" service.
+func NewIntrospectInternalServerErrorResponseBody(res *goa.ServiceError) *IntrospectInternalServerErrorResponseBody {
+	body := &IntrospectInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewIssueInput builds a token service issue endpoint payload.
 func NewIssueInput(body *IssueRequestBody) *token.IssueInput {
 	v := &token.IssueInput{
-		Username: *body.Username,
-		Password: *body.Password,
+		Username:     *body.Username,
+		Password:     *body.Password,
+		ClientID:     body.ClientID,
+		ClientSecret: body.ClientSecret,
+		Scope:        body.Scope,
+	}
+	if body.Audience != nil {
+		v.Audience = make([]string, len(body.Audience))
+		for i, val := range body.Audience {
+			v.Audience[i] = val
+		}
 	}
 
 	return v
@@ -189,13 +450,33 @@ func NewIssueInput(body *IssueRequestBody) *token.IssueInput {
 // NewRefreshInput builds a token service refresh endpoint payload.
 func NewRefreshInput(body *RefreshRequestBody) *token.RefreshInput {
 	v := &token.RefreshInput{
-		AccessToken:  *body.AccessToken,
+		AccessToken:  body.AccessToken,
 		RefreshToken: *body.RefreshToken,
 	}
 
 	return v
 }
 
+// NewRevokeInput builds a token service revoke endpoint payload.
+func NewRevokeInput(body *RevokeRequestBody) *token.RevokeInput {
+	v := &token.RevokeInput{
+		Token:         *body.Token,
+		TokenTypeHint: body.TokenTypeHint,
+	}
+
+	return v
+}
+
+// NewIntrospectInput builds a token service introspect endpoint payload.
+func NewIntrospectInput(body *IntrospectRequestBody) *token.IntrospectInput {
+	v := &token.IntrospectInput{
+		Token:         *body.Token,
+		TokenTypeHint: body.TokenTypeHint,
+	}
+
+	return v
+}
+
 // ValidateIssueRequestBody runs the validations defined on IssueRequestBody
 func ValidateIssueRequestBody(body *IssueRequestBody) (err error) {
 	if body.Username == nil {
@@ -204,16 +485,45 @@ func ValidateIssueRequestBody(body *IssueRequestBody) (err error) {
 	if body.Password == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("password", "body"))
 	}
+	if body.Username != nil {
+		if utf8.RuneCountInString(*body.Username) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", *body.Username, utf8.RuneCountInString(*body.Username), 256, false))
+		}
+	}
 	return
 }
 
 // ValidateRefreshRequestBody runs the validations defined on RefreshRequestBody
 func ValidateRefreshRequestBody(body *RefreshRequestBody) (err error) {
-	if body.AccessToken == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
-	}
 	if body.RefreshToken == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("refresh_token", "body"))
 	}
 	return
 }
+
+// ValidateRevokeRequestBody runs the validations defined on RevokeRequestBody
+func ValidateRevokeRequestBody(body *RevokeRequestBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	if body.TokenTypeHint != nil {
+		if !(*body.TokenTypeHint == "access_token" || *body.TokenTypeHint == "refresh_token") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_type_hint", *body.TokenTypeHint, []any{"access_token", "refresh_token"}))
+		}
+	}
+	return
+}
+
+// ValidateIntrospectRequestBody runs the validations defined on
+// IntrospectRequestBody
+func ValidateIntrospectRequestBody(body *IntrospectRequestBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	if body.TokenTypeHint != nil {
+		if !(*body.TokenTypeHint == "access_token" || *body.TokenTypeHint == "refresh_token") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_type_hint", *body.TokenTypeHint, []any{"access_token", "refresh_token"}))
+		}
+	}
+	return
+}
@@ -10,8 +10,10 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"unicode/utf8"
 
 	user "github.com/neatflowcv/key-stone/gen/user"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildCreatePayload builds the payload for the user create endpoint from CLI
@@ -22,12 +24,22 @@ func BuildCreatePayload(userCreateBody string) (*user.UserInput, error) {
 	{
 		err = json.Unmarshal([]byte(userCreateBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"password\": \"Occaecati vel adipisci.\",\n      \"username\": \"Quia architecto veritatis deleniti ea perferendis.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"eleonore@kilback.com\",\n      \"password\": \"A earum placeat sed sint.\",\n      \"username\": \"zgz\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Username) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", body.Username, utf8.RuneCountInString(body.Username), 256, false))
+		}
+		if body.Email != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 	v := &user.UserInput{
 		Username: body.Username,
 		Password: body.Password,
+		Email:    body.Email,
 	}
 
 	return v, nil
@@ -45,3 +57,188 @@ func BuildDeletePayload(userDeleteAuthorization string) (*user.DeleteUserPayload
 
 	return v, nil
 }
+
+// BuildGetMePayload builds the payload for the user get_me endpoint from CLI
+// flags.
+func BuildGetMePayload(userGetMeAuthorization string) (*user.GetUserPayload, error) {
+	var authorization string
+	{
+		authorization = userGetMeAuthorization
+	}
+	v := &user.GetUserPayload{}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildUpdateMePayload builds the payload for the user update_me endpoint from
+// CLI flags.
+func BuildUpdateMePayload(userUpdateMeBody string, userUpdateMeAuthorization string) (*user.UpdateUserPayload, error) {
+	var err error
+	var body UpdateMeRequestBody
+	{
+		err = json.Unmarshal([]byte(userUpdateMeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attributes\": {\n         \"2lh\": \"ugx\",\n         \"qw9\": \"0r4\",\n         \"w\": \"3nk\"\n      },\n      \"display_name\": \"hzq\",\n      \"email\": \"holden_eichmann@powlowskihammes.net\",\n      \"locale\": \"tlg\"\n   }'")
+		}
+		if body.DisplayName != nil {
+			if utf8.RuneCountInString(*body.DisplayName) > 128 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", *body.DisplayName, utf8.RuneCountInString(*body.DisplayName), 128, false))
+			}
+		}
+		if body.Locale != nil {
+			if utf8.RuneCountInString(*body.Locale) > 35 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.locale", *body.Locale, utf8.RuneCountInString(*body.Locale), 35, false))
+			}
+		}
+		if len(body.Attributes) > 32 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.attributes", body.Attributes, len(body.Attributes), 32, false))
+		}
+		for k, v := range body.Attributes {
+			if utf8.RuneCountInString(k) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.attributes.key", k, utf8.RuneCountInString(k), 1, true))
+			}
+			if utf8.RuneCountInString(k) > 64 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.attributes.key", k, utf8.RuneCountInString(k), 64, false))
+			}
+			if utf8.RuneCountInString(v) > 1024 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.attributes[key]", v, utf8.RuneCountInString(v), 1024, false))
+			}
+		}
+		if body.Email != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var authorization string
+	{
+		authorization = userUpdateMeAuthorization
+	}
+	v := &user.UpdateUserPayload{
+		DisplayName: body.DisplayName,
+		Locale:      body.Locale,
+		Email:       body.Email,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make(map[string]string, len(body.Attributes))
+		for key, val := range body.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildChangePasswordPayload builds the payload for the user change_password
+// endpoint from CLI flags.
+func BuildChangePasswordPayload(userChangePasswordBody string, userChangePasswordAuthorization string) (*user.ChangePasswordPayload, error) {
+	var err error
+	var body ChangePasswordRequestBody
+	{
+		err = json.Unmarshal([]byte(userChangePasswordBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"current_password\": \"Nemo ut.\",\n      \"new_password\": \"Dolorem animi nisi.\"\n   }'")
+		}
+	}
+	var authorization string
+	{
+		authorization = userChangePasswordAuthorization
+	}
+	v := &user.ChangePasswordPayload{
+		CurrentPassword: body.CurrentPassword,
+		NewPassword:     body.NewPassword,
+	}
+	v.Authorization = authorization
+
+	return v, nil
+}
+
+// BuildRequestPasswordResetPayload builds the payload for the user
+// request_password_reset endpoint from CLI flags.
+func BuildRequestPasswordResetPayload(userRequestPasswordResetBody string) (*user.PasswordResetRequest, error) {
+	var err error
+	var body RequestPasswordResetRequestBody
+	{
+		err = json.Unmarshal([]byte(userRequestPasswordResetBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"username\": \"8xf\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Username) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", body.Username, utf8.RuneCountInString(body.Username), 256, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &user.PasswordResetRequest{
+		Username: body.Username,
+	}
+
+	return v, nil
+}
+
+// BuildResetPasswordPayload builds the payload for the user reset_password
+// endpoint from CLI flags.
+func BuildResetPasswordPayload(userResetPasswordBody string) (*user.PasswordResetInput, error) {
+	var err error
+	var body ResetPasswordRequestBody
+	{
+		err = json.Unmarshal([]byte(userResetPasswordBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"new_password\": \"Eligendi sint corrupti.\",\n      \"token\": \"Quia nulla consectetur.\"\n   }'")
+		}
+	}
+	v := &user.PasswordResetInput{
+		Token:       body.Token,
+		NewPassword: body.NewPassword,
+	}
+
+	return v, nil
+}
+
+// BuildRequestEmailVerificationPayload builds the payload for the user
+// request_email_verification endpoint from CLI flags.
+func BuildRequestEmailVerificationPayload(userRequestEmailVerificationBody string) (*user.EmailVerificationRequest, error) {
+	var err error
+	var body RequestEmailVerificationRequestBody
+	{
+		err = json.Unmarshal([]byte(userRequestEmailVerificationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"username\": \"0xg\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Username) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", body.Username, utf8.RuneCountInString(body.Username), 256, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &user.EmailVerificationRequest{
+		Username: body.Username,
+	}
+
+	return v, nil
+}
+
+// BuildVerifyEmailPayload builds the payload for the user verify_email
+// endpoint from CLI flags.
+func BuildVerifyEmailPayload(userVerifyEmailBody string) (*user.EmailVerificationInput, error) {
+	var err error
+	var body VerifyEmailRequestBody
+	{
+		err = json.Unmarshal([]byte(userVerifyEmailBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Neque quia error.\"\n   }'")
+		}
+	}
+	v := &user.EmailVerificationInput{
+		Token: body.Token,
+	}
+
+	return v, nil
+}
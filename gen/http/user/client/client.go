@@ -23,6 +23,33 @@ type Client struct {
 	// Delete Doer is the HTTP client used to make requests to the delete endpoint.
 	DeleteDoer goahttp.Doer
 
+	// GetMe Doer is the HTTP client used to make requests to the get_me endpoint.
+	GetMeDoer goahttp.Doer
+
+	// UpdateMe Doer is the HTTP client used to make requests to the update_me
+	// endpoint.
+	UpdateMeDoer goahttp.Doer
+
+	// ChangePassword Doer is the HTTP client used to make requests to the
+	// change_password endpoint.
+	ChangePasswordDoer goahttp.Doer
+
+	// RequestPasswordReset Doer is the HTTP client used to make requests to the
+	// request_password_reset endpoint.
+	RequestPasswordResetDoer goahttp.Doer
+
+	// ResetPassword Doer is the HTTP client used to make requests to the
+	// reset_password endpoint.
+	ResetPasswordDoer goahttp.Doer
+
+	// RequestEmailVerification Doer is the HTTP client used to make requests to
+	// the request_email_verification endpoint.
+	RequestEmailVerificationDoer goahttp.Doer
+
+	// VerifyEmail Doer is the HTTP client used to make requests to the
+	// verify_email endpoint.
+	VerifyEmailDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -43,13 +70,20 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		CreateDoer:          doer,
-		DeleteDoer:          doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		CreateDoer:                   doer,
+		DeleteDoer:                   doer,
+		GetMeDoer:                    doer,
+		UpdateMeDoer:                 doer,
+		ChangePasswordDoer:           doer,
+		RequestPasswordResetDoer:     doer,
+		ResetPasswordDoer:            doer,
+		RequestEmailVerificationDoer: doer,
+		VerifyEmailDoer:              doer,
+		RestoreResponseBody:          restoreBody,
+		scheme:                       scheme,
+		host:                         host,
+		decoder:                      dec,
+		encoder:                      enc,
 	}
 }
 
@@ -100,3 +134,171 @@ func (c *Client) Delete() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// GetMe returns an endpoint that makes HTTP requests to the user service
+// get_me server.
+func (c *Client) GetMe() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetMeRequest(c.encoder)
+		decodeResponse = DecodeGetMeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetMeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetMeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "get_me", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateMe returns an endpoint that makes HTTP requests to the user service
+// update_me server.
+func (c *Client) UpdateMe() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateMeRequest(c.encoder)
+		decodeResponse = DecodeUpdateMeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateMeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateMeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "update_me", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ChangePassword returns an endpoint that makes HTTP requests to the user
+// service change_password server.
+func (c *Client) ChangePassword() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeChangePasswordRequest(c.encoder)
+		decodeResponse = DecodeChangePasswordResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildChangePasswordRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ChangePasswordDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "change_password", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RequestPasswordReset returns an endpoint that makes HTTP requests to the
+// user service request_password_reset server.
+func (c *Client) RequestPasswordReset() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRequestPasswordResetRequest(c.encoder)
+		decodeResponse = DecodeRequestPasswordResetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRequestPasswordResetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RequestPasswordResetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "request_password_reset", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ResetPassword returns an endpoint that makes HTTP requests to the user
+// service reset_password server.
+func (c *Client) ResetPassword() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeResetPasswordRequest(c.encoder)
+		decodeResponse = DecodeResetPasswordResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildResetPasswordRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ResetPasswordDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "reset_password", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RequestEmailVerification returns an endpoint that makes HTTP requests to the
+// user service request_email_verification server.
+func (c *Client) RequestEmailVerification() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRequestEmailVerificationRequest(c.encoder)
+		decodeResponse = DecodeRequestEmailVerificationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRequestEmailVerificationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RequestEmailVerificationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "request_email_verification", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// VerifyEmail returns an endpoint that makes HTTP requests to the user service
+// verify_email server.
+func (c *Client) VerifyEmail() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeVerifyEmailRequest(c.encoder)
+		decodeResponse = DecodeVerifyEmailResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildVerifyEmailRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.VerifyEmailDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("user", "verify_email", err)
+		}
+		return decodeResponse(resp)
+	}
+}
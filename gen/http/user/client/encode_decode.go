@@ -53,6 +53,7 @@ func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http
 // create endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
 // DecodeCreateResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
 //   - "UserAlreadyExists" (type *goa.ServiceError): http.StatusUnauthorized
 //   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
 //   - error: internal error
@@ -73,6 +74,20 @@ func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 		switch resp.StatusCode {
 		case http.StatusNoContent:
 			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "create", err)
+			}
+			err = ValidateCreateBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "create", err)
+			}
+			return nil, NewCreateBadRequest(&body)
 		case http.StatusUnauthorized:
 			var (
 				body CreateUserAlreadyExistsResponseBody
@@ -197,3 +212,744 @@ func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 		}
 	}
 }
+
+// BuildGetMeRequest instantiates a HTTP request object with method and path
+// set to call the "user" service "get_me" endpoint
+func (c *Client) BuildGetMeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetMeUserPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "get_me", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetMeRequest returns an encoder for requests sent to the user get_me
+// server.
+func EncodeGetMeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.GetUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "get_me", "*user.GetUserPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeGetMeResponse returns a decoder for responses returned by the user
+// get_me endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeGetMeResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetMeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetMeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "get_me", err)
+			}
+			err = ValidateGetMeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "get_me", err)
+			}
+			res := NewGetMeUserProfileOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body GetMeUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "get_me", err)
+			}
+			err = ValidateGetMeUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "get_me", err)
+			}
+			return nil, NewGetMeUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetMeInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "get_me", err)
+			}
+			err = ValidateGetMeInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "get_me", err)
+			}
+			return nil, NewGetMeInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "get_me", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateMeRequest instantiates a HTTP request object with method and path
+// set to call the "user" service "update_me" endpoint
+func (c *Client) BuildUpdateMeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateMeUserPath()}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "update_me", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateMeRequest returns an encoder for requests sent to the user
+// update_me server.
+func EncodeUpdateMeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.UpdateUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "update_me", "*user.UpdateUserPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		body := NewUpdateMeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("user", "update_me", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateMeResponse returns a decoder for responses returned by the user
+// update_me endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeUpdateMeResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUpdateMeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateMeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "update_me", err)
+			}
+			err = ValidateUpdateMeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "update_me", err)
+			}
+			res := NewUpdateMeUserProfileOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateMeBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "update_me", err)
+			}
+			err = ValidateUpdateMeBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "update_me", err)
+			}
+			return nil, NewUpdateMeBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateMeUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "update_me", err)
+			}
+			err = ValidateUpdateMeUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "update_me", err)
+			}
+			return nil, NewUpdateMeUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateMeInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "update_me", err)
+			}
+			err = ValidateUpdateMeInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "update_me", err)
+			}
+			return nil, NewUpdateMeInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "update_me", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildChangePasswordRequest instantiates a HTTP request object with method
+// and path set to call the "user" service "change_password" endpoint
+func (c *Client) BuildChangePasswordRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ChangePasswordUserPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "change_password", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeChangePasswordRequest returns an encoder for requests sent to the user
+// change_password server.
+func EncodeChangePasswordRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.ChangePasswordPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "change_password", "*user.ChangePasswordPayload", v)
+		}
+		{
+			head := p.Authorization
+			req.Header.Set("Authorization", head)
+		}
+		body := NewChangePasswordRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("user", "change_password", err)
+		}
+		return nil
+	}
+}
+
+// DecodeChangePasswordResponse returns a decoder for responses returned by the
+// user change_password endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeChangePasswordResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeChangePasswordResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body ChangePasswordBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "change_password", err)
+			}
+			err = ValidateChangePasswordBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "change_password", err)
+			}
+			return nil, NewChangePasswordBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ChangePasswordUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "change_password", err)
+			}
+			err = ValidateChangePasswordUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "change_password", err)
+			}
+			return nil, NewChangePasswordUnauthorized(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ChangePasswordInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "change_password", err)
+			}
+			err = ValidateChangePasswordInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "change_password", err)
+			}
+			return nil, NewChangePasswordInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "change_password", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRequestPasswordResetRequest instantiates a HTTP request object with
+// method and path set to call the "user" service "request_password_reset"
+// endpoint
+func (c *Client) BuildRequestPasswordResetRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RequestPasswordResetUserPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "request_password_reset", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRequestPasswordResetRequest returns an encoder for requests sent to
+// the user request_password_reset server.
+func EncodeRequestPasswordResetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.PasswordResetRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "request_password_reset", "*user.PasswordResetRequest", v)
+		}
+		body := NewRequestPasswordResetRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("user", "request_password_reset", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRequestPasswordResetResponse returns a decoder for responses returned
+// by the user request_password_reset endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeRequestPasswordResetResponse may return the following errors:
+//   - "NotImplemented" (type *goa.ServiceError): http.StatusNotImplemented
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRequestPasswordResetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusNotImplemented:
+			var (
+				body RequestPasswordResetNotImplementedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "request_password_reset", err)
+			}
+			err = ValidateRequestPasswordResetNotImplementedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "request_password_reset", err)
+			}
+			return nil, NewRequestPasswordResetNotImplemented(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RequestPasswordResetInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "request_password_reset", err)
+			}
+			err = ValidateRequestPasswordResetInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "request_password_reset", err)
+			}
+			return nil, NewRequestPasswordResetInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "request_password_reset", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildResetPasswordRequest instantiates a HTTP request object with method and
+// path set to call the "user" service "reset_password" endpoint
+func (c *Client) BuildResetPasswordRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ResetPasswordUserPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "reset_password", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeResetPasswordRequest returns an encoder for requests sent to the user
+// reset_password server.
+func EncodeResetPasswordRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.PasswordResetInput)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "reset_password", "*user.PasswordResetInput", v)
+		}
+		body := NewResetPasswordRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("user", "reset_password", err)
+		}
+		return nil
+	}
+}
+
+// DecodeResetPasswordResponse returns a decoder for responses returned by the
+// user reset_password endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeResetPasswordResponse may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): http.StatusBadRequest
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "NotImplemented" (type *goa.ServiceError): http.StatusNotImplemented
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeResetPasswordResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body ResetPasswordBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "reset_password", err)
+			}
+			err = ValidateResetPasswordBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "reset_password", err)
+			}
+			return nil, NewResetPasswordBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ResetPasswordUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "reset_password", err)
+			}
+			err = ValidateResetPasswordUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "reset_password", err)
+			}
+			return nil, NewResetPasswordUnauthorized(&body)
+		case http.StatusNotImplemented:
+			var (
+				body ResetPasswordNotImplementedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "reset_password", err)
+			}
+			err = ValidateResetPasswordNotImplementedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "reset_password", err)
+			}
+			return nil, NewResetPasswordNotImplemented(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ResetPasswordInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "reset_password", err)
+			}
+			err = ValidateResetPasswordInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "reset_password", err)
+			}
+			return nil, NewResetPasswordInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "reset_password", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRequestEmailVerificationRequest instantiates a HTTP request object with
+// method and path set to call the "user" service "request_email_verification"
+// endpoint
+func (c *Client) BuildRequestEmailVerificationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RequestEmailVerificationUserPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "request_email_verification", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRequestEmailVerificationRequest returns an encoder for requests sent
+// to the user request_email_verification server.
+func EncodeRequestEmailVerificationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.EmailVerificationRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "request_email_verification", "*user.EmailVerificationRequest", v)
+		}
+		body := NewRequestEmailVerificationRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("user", "request_email_verification", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRequestEmailVerificationResponse returns a decoder for responses
+// returned by the user request_email_verification endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeRequestEmailVerificationResponse may return the following errors:
+//   - "NotImplemented" (type *goa.ServiceError): http.StatusNotImplemented
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRequestEmailVerificationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusNotImplemented:
+			var (
+				body RequestEmailVerificationNotImplementedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "request_email_verification", err)
+			}
+			err = ValidateRequestEmailVerificationNotImplementedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "request_email_verification", err)
+			}
+			return nil, NewRequestEmailVerificationNotImplemented(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RequestEmailVerificationInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "request_email_verification", err)
+			}
+			err = ValidateRequestEmailVerificationInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "request_email_verification", err)
+			}
+			return nil, NewRequestEmailVerificationInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "request_email_verification", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildVerifyEmailRequest instantiates a HTTP request object with method and
+// path set to call the "user" service "verify_email" endpoint
+func (c *Client) BuildVerifyEmailRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: VerifyEmailUserPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("user", "verify_email", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeVerifyEmailRequest returns an encoder for requests sent to the user
+// verify_email server.
+func EncodeVerifyEmailRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*user.EmailVerificationInput)
+		if !ok {
+			return goahttp.ErrInvalidType("user", "verify_email", "*user.EmailVerificationInput", v)
+		}
+		body := NewVerifyEmailRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("user", "verify_email", err)
+		}
+		return nil
+	}
+}
+
+// DecodeVerifyEmailResponse returns a decoder for responses returned by the
+// user verify_email endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeVerifyEmailResponse may return the following errors:
+//   - "Unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "NotImplemented" (type *goa.ServiceError): http.StatusNotImplemented
+//   - "InternalServerError" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeVerifyEmailResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body VerifyEmailUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "verify_email", err)
+			}
+			err = ValidateVerifyEmailUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "verify_email", err)
+			}
+			return nil, NewVerifyEmailUnauthorized(&body)
+		case http.StatusNotImplemented:
+			var (
+				body VerifyEmailNotImplementedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "verify_email", err)
+			}
+			err = ValidateVerifyEmailNotImplementedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "verify_email", err)
+			}
+			return nil, NewVerifyEmailNotImplemented(&body)
+		case http.StatusInternalServerError:
+			var (
+				body VerifyEmailInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("user", "verify_email", err)
+			}
+			err = ValidateVerifyEmailInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("user", "verify_email", err)
+			}
+			return nil, NewVerifyEmailInternalServerError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("user", "verify_email", resp.StatusCode, string(body))
+		}
+	}
+}
@@ -16,3 +16,38 @@ func CreateUserPath() string {
 func DeleteUserPath() string {
 	return "/key-stone/users/me"
 }
+
+// GetMeUserPath returns the URL path to the user service get_me HTTP endpoint.
+func GetMeUserPath() string {
+	return "/key-stone/users/me"
+}
+
+// UpdateMeUserPath returns the URL path to the user service update_me HTTP endpoint.
+func UpdateMeUserPath() string {
+	return "/key-stone/users/me"
+}
+
+// ChangePasswordUserPath returns the URL path to the user service change_password HTTP endpoint.
+func ChangePasswordUserPath() string {
+	return "/key-stone/users/me/password"
+}
+
+// RequestPasswordResetUserPath returns the URL path to the user service request_password_reset HTTP endpoint.
+func RequestPasswordResetUserPath() string {
+	return "/key-stone/users/password-reset"
+}
+
+// ResetPasswordUserPath returns the URL path to the user service reset_password HTTP endpoint.
+func ResetPasswordUserPath() string {
+	return "/key-stone/users/password-reset"
+}
+
+// RequestEmailVerificationUserPath returns the URL path to the user service request_email_verification HTTP endpoint.
+func RequestEmailVerificationUserPath() string {
+	return "/key-stone/users/email-verification"
+}
+
+// VerifyEmailUserPath returns the URL path to the user service verify_email HTTP endpoint.
+func VerifyEmailUserPath() string {
+	return "/key-stone/users/email-verification"
+}
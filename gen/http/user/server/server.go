@@ -18,9 +18,16 @@ import (
 
 // Server lists the user service endpoint HTTP handlers.
 type Server struct {
-	Mounts []*MountPoint
-	Create http.Handler
-	Delete http.Handler
+	Mounts                   []*MountPoint
+	Create                   http.Handler
+	Delete                   http.Handler
+	GetMe                    http.Handler
+	UpdateMe                 http.Handler
+	ChangePassword           http.Handler
+	RequestPasswordReset     http.Handler
+	ResetPassword            http.Handler
+	RequestEmailVerification http.Handler
+	VerifyEmail              http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -52,9 +59,23 @@ func New(
 		Mounts: []*MountPoint{
 			{"Create", "POST", "/key-stone/users"},
 			{"Delete", "DELETE", "/key-stone/users/me"},
+			{"GetMe", "GET", "/key-stone/users/me"},
+			{"UpdateMe", "PATCH", "/key-stone/users/me"},
+			{"ChangePassword", "PUT", "/key-stone/users/me/password"},
+			{"RequestPasswordReset", "POST", "/key-stone/users/password-reset"},
+			{"ResetPassword", "PUT", "/key-stone/users/password-reset"},
+			{"RequestEmailVerification", "POST", "/key-stone/users/email-verification"},
+			{"VerifyEmail", "PUT", "/key-stone/users/email-verification"},
 		},
-		Create: NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
-		Delete: NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		Create:                   NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
+		Delete:                   NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		GetMe:                    NewGetMeHandler(e.GetMe, mux, decoder, encoder, errhandler, formatter),
+		UpdateMe:                 NewUpdateMeHandler(e.UpdateMe, mux, decoder, encoder, errhandler, formatter),
+		ChangePassword:           NewChangePasswordHandler(e.ChangePassword, mux, decoder, encoder, errhandler, formatter),
+		RequestPasswordReset:     NewRequestPasswordResetHandler(e.RequestPasswordReset, mux, decoder, encoder, errhandler, formatter),
+		ResetPassword:            NewResetPasswordHandler(e.ResetPassword, mux, decoder, encoder, errhandler, formatter),
+		RequestEmailVerification: NewRequestEmailVerificationHandler(e.RequestEmailVerification, mux, decoder, encoder, errhandler, formatter),
+		VerifyEmail:              NewVerifyEmailHandler(e.VerifyEmail, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -65,6 +86,13 @@ func (s *Server) Service() string { return "user" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Create = m(s.Create)
 	s.Delete = m(s.Delete)
+	s.GetMe = m(s.GetMe)
+	s.UpdateMe = m(s.UpdateMe)
+	s.ChangePassword = m(s.ChangePassword)
+	s.RequestPasswordReset = m(s.RequestPasswordReset)
+	s.ResetPassword = m(s.ResetPassword)
+	s.RequestEmailVerification = m(s.RequestEmailVerification)
+	s.VerifyEmail = m(s.VerifyEmail)
 }
 
 // MethodNames returns the methods served.
@@ -74,6 +102,13 @@ func (s *Server) MethodNames() []string { return user.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountCreateHandler(mux, h.Create)
 	MountDeleteHandler(mux, h.Delete)
+	MountGetMeHandler(mux, h.GetMe)
+	MountUpdateMeHandler(mux, h.UpdateMe)
+	MountChangePasswordHandler(mux, h.ChangePassword)
+	MountRequestPasswordResetHandler(mux, h.RequestPasswordReset)
+	MountResetPasswordHandler(mux, h.ResetPassword)
+	MountRequestEmailVerificationHandler(mux, h.RequestEmailVerification)
+	MountVerifyEmailHandler(mux, h.VerifyEmail)
 }
 
 // Mount configures the mux to serve the user endpoints.
@@ -186,3 +221,375 @@ func NewDeleteHandler(
 		}
 	})
 }
+
+// MountGetMeHandler configures the mux to serve the "user" service "get_me"
+// endpoint.
+func MountGetMeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/key-stone/users/me", f)
+}
+
+// NewGetMeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "user" service "get_me" endpoint.
+func NewGetMeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetMeRequest(mux, decoder)
+		encodeResponse = EncodeGetMeResponse(encoder)
+		encodeError    = EncodeGetMeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_me")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateMeHandler configures the mux to serve the "user" service
+// "update_me" endpoint.
+func MountUpdateMeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/key-stone/users/me", f)
+}
+
+// NewUpdateMeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "user" service "update_me" endpoint.
+func NewUpdateMeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateMeRequest(mux, decoder)
+		encodeResponse = EncodeUpdateMeResponse(encoder)
+		encodeError    = EncodeUpdateMeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update_me")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountChangePasswordHandler configures the mux to serve the "user" service
+// "change_password" endpoint.
+func MountChangePasswordHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/key-stone/users/me/password", f)
+}
+
+// NewChangePasswordHandler creates a HTTP handler which loads the HTTP request
+// and calls the "user" service "change_password" endpoint.
+func NewChangePasswordHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeChangePasswordRequest(mux, decoder)
+		encodeResponse = EncodeChangePasswordResponse(encoder)
+		encodeError    = EncodeChangePasswordError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "change_password")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRequestPasswordResetHandler configures the mux to serve the "user"
+// service "request_password_reset" endpoint.
+func MountRequestPasswordResetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/key-stone/users/password-reset", f)
+}
+
+// NewRequestPasswordResetHandler creates a HTTP handler which loads the HTTP
+// request and calls the "user" service "request_password_reset" endpoint.
+func NewRequestPasswordResetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRequestPasswordResetRequest(mux, decoder)
+		encodeResponse = EncodeRequestPasswordResetResponse(encoder)
+		encodeError    = EncodeRequestPasswordResetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "request_password_reset")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountResetPasswordHandler configures the mux to serve the "user" service
+// "reset_password" endpoint.
+func MountResetPasswordHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/key-stone/users/password-reset", f)
+}
+
+// NewResetPasswordHandler creates a HTTP handler which loads the HTTP request
+// and calls the "user" service "reset_password" endpoint.
+func NewResetPasswordHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeResetPasswordRequest(mux, decoder)
+		encodeResponse = EncodeResetPasswordResponse(encoder)
+		encodeError    = EncodeResetPasswordError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "reset_password")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRequestEmailVerificationHandler configures the mux to serve the "user"
+// service "request_email_verification" endpoint.
+func MountRequestEmailVerificationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/key-stone/users/email-verification", f)
+}
+
+// NewRequestEmailVerificationHandler creates a HTTP handler which loads the
+// HTTP request and calls the "user" service "request_email_verification"
+// endpoint.
+func NewRequestEmailVerificationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRequestEmailVerificationRequest(mux, decoder)
+		encodeResponse = EncodeRequestEmailVerificationResponse(encoder)
+		encodeError    = EncodeRequestEmailVerificationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "request_email_verification")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountVerifyEmailHandler configures the mux to serve the "user" service
+// "verify_email" endpoint.
+func MountVerifyEmailHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/key-stone/users/email-verification", f)
+}
+
+// NewVerifyEmailHandler creates a HTTP handler which loads the HTTP request
+// and calls the "user" service "verify_email" endpoint.
+func NewVerifyEmailHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeVerifyEmailRequest(mux, decoder)
+		encodeResponse = EncodeVerifyEmailResponse(encoder)
+		encodeError    = EncodeVerifyEmailError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "verify_email")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "user")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
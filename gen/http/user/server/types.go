@@ -8,6 +8,8 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	user "github.com/neatflowcv/key-stone/gen/user"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -15,10 +17,125 @@ import (
 // CreateRequestBody is the type of the "user" service "create" endpoint HTTP
 // request body.
 type CreateRequestBody struct {
-	// The name of the user
+	// The name of the user, compared case-insensitively
 	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 	// The password of the user
 	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// The address password resets and verification tokens are sent to
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+}
+
+// UpdateMeRequestBody is the type of the "user" service "update_me" endpoint
+// HTTP request body.
+type UpdateMeRequestBody struct {
+	// The name the user is shown as, unchanged if omitted
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// The BCP 47 language tag the user prefers, unchanged if omitted
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// The key/value pairs of the applications, replacing every attribute,
+	// unchanged if omitted
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// A new email, unverified until the user verifies it, unchanged if omitted
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+}
+
+// ChangePasswordRequestBody is the type of the "user" service
+// "change_password" endpoint HTTP request body.
+type ChangePasswordRequestBody struct {
+	// The password the user has now
+	CurrentPassword *string `form:"current_password,omitempty" json:"current_password,omitempty" xml:"current_password,omitempty"`
+	// The password replacing it, checked against the password policy
+	NewPassword *string `form:"new_password,omitempty" json:"new_password,omitempty" xml:"new_password,omitempty"`
+}
+
+// RequestPasswordResetRequestBody is the type of the "user" service
+// "request_password_reset" endpoint HTTP request body.
+type RequestPasswordResetRequestBody struct {
+	// The user who forgot the password
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// ResetPasswordRequestBody is the type of the "user" service "reset_password"
+// endpoint HTTP request body.
+type ResetPasswordRequestBody struct {
+	// The reset token mailed to the user
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// The new password, checked against the password policy
+	NewPassword *string `form:"new_password,omitempty" json:"new_password,omitempty" xml:"new_password,omitempty"`
+}
+
+// RequestEmailVerificationRequestBody is the type of the "user" service
+// "request_email_verification" endpoint HTTP request body.
+type RequestEmailVerificationRequestBody struct {
+	// The user whose email is not verified yet
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// VerifyEmailRequestBody is the type of the "user" service "verify_email"
+// endpoint HTTP request body.
+type VerifyEmailRequestBody struct {
+	// The verification token mailed to the user
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+}
+
+// GetMeResponseBody is the type of the "user" service "get_me" endpoint HTTP
+// response body.
+type GetMeResponseBody struct {
+	// The name of the user
+	Username string `form:"username" json:"username" xml:"username"`
+	// The email of the user
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Whether the user verified the email
+	EmailVerified bool `form:"email_verified" json:"email_verified" xml:"email_verified"`
+	// The name the user is shown as
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// The BCP 47 language tag the user prefers
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// The key/value pairs of the applications
+	Attributes map[string]string `form:"attributes" json:"attributes" xml:"attributes"`
+	// The time the user was created, unknown for users of older versions
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The time the user or an admin last changed the profile or the password
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// UpdateMeResponseBody is the type of the "user" service "update_me" endpoint
+// HTTP response body.
+type UpdateMeResponseBody struct {
+	// The name of the user
+	Username string `form:"username" json:"username" xml:"username"`
+	// The email of the user
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Whether the user verified the email
+	EmailVerified bool `form:"email_verified" json:"email_verified" xml:"email_verified"`
+	// The name the user is shown as
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// The BCP 47 language tag the user prefers
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// The key/value pairs of the applications
+	Attributes map[string]string `form:"attributes" json:"attributes" xml:"attributes"`
+	// The time the user was created, unknown for users of older versions
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The time the user or an admin last changed the profile or the password
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// CreateBadRequestResponseBody is the type of the "user" service "create"
+// endpoint HTTP response body for the "BadRequest" error.
+type CreateBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
 // CreateUserAlreadyExistsResponseBody is the type of the "user" service
@@ -93,87 +210,951 @@ type DeleteInternalServerErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateUserAlreadyExistsResponseBody builds the HTTP response body from
-// the result of the "create" endpoint of the "user" service.
-func NewCreateUserAlreadyExistsResponseBody(res *goa.ServiceError) *CreateUserAlreadyExistsResponseBody {
-	body := &CreateUserAlreadyExistsResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// GetMeUnauthorizedResponseBody is the type of the "user" service "get_me"
+// endpoint HTTP response body for the "Unauthorized" error.
+type GetMeUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateInternalServerErrorResponseBody builds the HTTP response body from
-// the result of the "create" endpoint of the "user" service.
-func NewCreateInternalServerErrorResponseBody(res *goa.ServiceError) *CreateInternalServerErrorResponseBody {
-	body := &CreateInternalServerErrorResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// GetMeInternalServerErrorResponseBody is the type of the "user" service
+// "get_me" endpoint HTTP response body for the "InternalServerError" error.
+type GetMeInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewDeleteUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "delete" endpoint of the "user" service.
-func NewDeleteUnauthorizedResponseBody(res *goa.ServiceError) *DeleteUnauthorizedResponseBody {
-	body := &DeleteUnauthorizedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// UpdateMeBadRequestResponseBody is the type of the "user" service "update_me"
+// endpoint HTTP response body for the "BadRequest" error.
+type UpdateMeBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewDeleteInternalServerErrorResponseBody builds the HTTP response body from
-// the result of the "delete" endpoint of the "user" service.
-func NewDeleteInternalServerErrorResponseBody(res *goa.ServiceError) *DeleteInternalServerErrorResponseBody {
-	body := &DeleteInternalServerErrorResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// UpdateMeUnauthorizedResponseBody is the type of the "user" service
+// "update_me" endpoint HTTP response body for the "Unauthorized" error.
+type UpdateMeUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateUserInput builds a user service create endpoint payload.
-func NewCreateUserInput(body *CreateRequestBody) *user.UserInput {
-	v := &user.UserInput{
-		Username: *body.Username,
-		Password: *body.Password,
-	}
+// UpdateMeInternalServerErrorResponseBody is the type of the "user" service
+// "update_me" endpoint HTTP response body for the "InternalServerError" error.
+type UpdateMeInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
 
-	return v
+// ChangePasswordBadRequestResponseBody is the type of the "user" service
+// "change_password" endpoint HTTP response body for the "BadRequest" error.
+type ChangePasswordBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewDeleteUserPayload builds a user service delete endpoint payload.
-func NewDeleteUserPayload(authorization string) *user.DeleteUserPayload {
-	v := &user.DeleteUserPayload{}
-	v.Authorization = authorization
+// ChangePasswordUnauthorizedResponseBody is the type of the "user" service
+// "change_password" endpoint HTTP response body for the "Unauthorized" error.
+type ChangePasswordUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
 
-	return v
+// ChangePasswordInternalServerErrorResponseBody is the type of the "user"
+// service "change_password" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ChangePasswordInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
-func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
-	if body.Username == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("username", "body"))
-	}
-	if body.Password == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("password", "body"))
+// RequestPasswordResetNotImplementedResponseBody is the type of the "user"
+// service "request_password_reset" endpoint HTTP response body for the
+// "NotImplemented" error.
+type RequestPasswordResetNotImplementedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RequestPasswordResetInternalServerErrorResponseBody is the type of the
+// "user" service "request_password_reset" endpoint HTTP response body for the
+// "InternalServerError" error.
+type RequestPasswordResetInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ResetPasswordBadRequestResponseBody is the type of the "user" service
+// "reset_password" endpoint HTTP response body for the "BadRequest" error.
+type ResetPasswordBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ResetPasswordUnauthorizedResponseBody is the type of the "user" service
+// "reset_password" endpoint HTTP response body for the "Unauthorized" error.
+type ResetPasswordUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ResetPasswordNotImplementedResponseBody is the type of the "user" service
+// "reset_password" endpoint HTTP response body for the "NotImplemented" error.
+type ResetPasswordNotImplementedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ResetPasswordInternalServerErrorResponseBody is the type of the "user"
+// service "reset_password" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ResetPasswordInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RequestEmailVerificationNotImplementedResponseBody is the type of the "user"
+// service "request_email_verification" endpoint HTTP response body for the
+// "NotImplemented" error.
+type RequestEmailVerificationNotImplementedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RequestEmailVerificationInternalServerErrorResponseBody is the type of the
+// "user" service "request_email_verification" endpoint HTTP response body for
+// the "InternalServerError" error.
+type RequestEmailVerificationInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// VerifyEmailUnauthorizedResponseBody is the type of the "user" service
+// "verify_email" endpoint HTTP response body for the "Unauthorized" error.
+type VerifyEmailUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// VerifyEmailNotImplementedResponseBody is the type of the "user" service
+// "verify_email" endpoint HTTP response body for the "NotImplemented" error.
+type VerifyEmailNotImplementedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// VerifyEmailInternalServerErrorResponseBody is the type of the "user" service
+// "verify_email" endpoint HTTP response body for the "InternalServerError"
+// error.
+type VerifyEmailInternalServerErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// NewGetMeResponseBody builds the HTTP response body from the result of the
+// "get_me" endpoint of the "user" service.
+func NewGetMeResponseBody(res *user.UserProfile) *GetMeResponseBody {
+	body := &GetMeResponseBody{
+		Username:      res.Username,
+		Email:         res.Email,
+		EmailVerified: res.EmailVerified,
+		DisplayName:   res.DisplayName,
+		Locale:        res.Locale,
+		CreatedAt:     res.CreatedAt,
+		UpdatedAt:     res.UpdatedAt,
+	}
+	if res.Attributes != nil {
+		body.Attributes = make(map[string]string, len(res.Attributes))
+		for key, val := range res.Attributes {
+			tk := key
+			tv := val
+			body.Attributes[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewUpdateMeResponseBody builds the HTTP response body from the result of the
+// "update_me" endpoint of the "user" service.
+func NewUpdateMeResponseBody(res *user.UserProfile) *UpdateMeResponseBody {
+	body := &UpdateMeResponseBody{
+		Username:      res.Username,
+		Email:         res.Email,
+		EmailVerified: res.EmailVerified,
+		DisplayName:   res.DisplayName,
+		Locale:        res.Locale,
+		CreatedAt:     res.CreatedAt,
+		UpdatedAt:     res.UpdatedAt,
+	}
+	if res.Attributes != nil {
+		body.Attributes = make(map[string]string, len(res.Attributes))
+		for key, val := range res.Attributes {
+			tk := key
+			tv := val
+			body.Attributes[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewCreateBadRequestResponseBody builds the HTTP response body from the
+// result of the "create" endpoint of the "user" service.
+func NewCreateBadRequestResponseBody(res *goa.ServiceError) *CreateBadRequestResponseBody {
+	body := &CreateBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateUserAlreadyExistsResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "user" service.
+func NewCreateUserAlreadyExistsResponseBody(res *goa.ServiceError) *CreateUserAlreadyExistsResponseBody {
+	body := &CreateUserAlreadyExistsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "create" endpoint of the "user" service.
+func NewCreateInternalServerErrorResponseBody(res *goa.ServiceError) *CreateInternalServerErrorResponseBody {
+	body := &CreateInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "delete" endpoint of the "user" service.
+func NewDeleteUnauthorizedResponseBody(res *goa.ServiceError) *DeleteUnauthorizedResponseBody {
+	body := &DeleteUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "delete" endpoint of the "user" service.
+func NewDeleteInternalServerErrorResponseBody(res *goa.ServiceError) *DeleteInternalServerErrorResponseBody {
+	body := &DeleteInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMeUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "get_me" endpoint of the "user" service.
+func NewGetMeUnauthorizedResponseBody(res *goa.ServiceError) *GetMeUnauthorizedResponseBody {
+	body := &GetMeUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMeInternalServerErrorResponseBody builds the HTTP response body from
+// the result of the "get_me" endpoint of the "user" service.
+func NewGetMeInternalServerErrorResponseBody(res *goa.ServiceError) *GetMeInternalServerErrorResponseBody {
+	body := &GetMeInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMeBadRequestResponseBody builds the HTTP response body from the
+// result of the "update_me" endpoint of the "user" service.
+func NewUpdateMeBadRequestResponseBody(res *goa.ServiceError) *UpdateMeBadRequestResponseBody {
+	body := &UpdateMeBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMeUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "update_me" endpoint of the "user" service.
+func NewUpdateMeUnauthorizedResponseBody(res *goa.ServiceError) *UpdateMeUnauthorizedResponseBody {
+	body := &UpdateMeUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMeInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "update_me" endpoint of the "user" service.
+func NewUpdateMeInternalServerErrorResponseBody(res *goa.ServiceError) *UpdateMeInternalServerErrorResponseBody {
+	body := &UpdateMeInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewChangePasswordBadRequestResponseBody builds the HTTP response body from
+// the result of the "change_password" endpoint of the "user" service.
+func NewChangePasswordBadRequestResponseBody(res *goa.ServiceError) *ChangePasswordBadRequestResponseBody {
+	body := &ChangePasswordBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewChangePasswordUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "change_password" endpoint of the "user" service.
+func NewChangePasswordUnauthorizedResponseBody(res *goa.ServiceError) *ChangePasswordUnauthorizedResponseBody {
+	body := &ChangePasswordUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewChangePasswordInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "change_password" endpoint of the "user" service.
+func NewChangePasswordInternalServerErrorResponseBody(res *goa.ServiceError) *ChangePasswordInternalServerErrorResponseBody {
+	body := &ChangePasswordInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestPasswordResetNotImplementedResponseBody builds the HTTP response
+// body from the result of the "request_password_reset" endpoint of the "user"
+// service.
+func NewRequestPasswordResetNotImplementedResponseBody(res *goa.ServiceError) *RequestPasswordResetNotImplementedResponseBody {
+	body := &RequestPasswordResetNotImplementedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestPasswordResetInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "request_password_reset" endpoint of
+// the "user" service.
+func NewRequestPasswordResetInternalServerErrorResponseBody(res *goa.ServiceError) *RequestPasswordResetInternalServerErrorResponseBody {
+	body := &RequestPasswordResetInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewResetPasswordBadRequestResponseBody builds the HTTP response body from
+// the result of the "reset_password" endpoint of the "user" service.
+func NewResetPasswordBadRequestResponseBody(res *goa.ServiceError) *ResetPasswordBadRequestResponseBody {
+	body := &ResetPasswordBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewResetPasswordUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "reset_password" endpoint of the "user" service.
+func NewResetPasswordUnauthorizedResponseBody(res *goa.ServiceError) *ResetPasswordUnauthorizedResponseBody {
+	body := &ResetPasswordUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewResetPasswordNotImplementedResponseBody builds the HTTP response body
+// from the result of the "reset_password" endpoint of the "user" service.
+func NewResetPasswordNotImplementedResponseBody(res *goa.ServiceError) *ResetPasswordNotImplementedResponseBody {
+	body := &ResetPasswordNotImplementedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewResetPasswordInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "reset_password" endpoint of the "user" service.
+func NewResetPasswordInternalServerErrorResponseBody(res *goa.ServiceError) *ResetPasswordInternalServerErrorResponseBody {
+	body := &ResetPasswordInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestEmailVerificationNotImplementedResponseBody builds the HTTP
+// response body from the result of the "request_email_verification" endpoint
+// of the "user" service.
+func NewRequestEmailVerificationNotImplementedResponseBody(res *goa.ServiceError) *RequestEmailVerificationNotImplementedResponseBody {
+	body := &RequestEmailVerificationNotImplementedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestEmailVerificationInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "request_email_verification" endpoint
+// of the "user" service.
+func NewRequestEmailVerificationInternalServerErrorResponseBody(res *goa.ServiceError) *RequestEmailVerificationInternalServerErrorResponseBody {
+	body := &RequestEmailVerificationInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewVerifyEmailUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "verify_email" endpoint of the "user" service.
+func NewVerifyEmailUnauthorizedResponseBody(res *goa.ServiceError) *VerifyEmailUnauthorizedResponseBody {
+	body := &VerifyEmailUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewVerifyEmailNotImplementedResponseBody builds the HTTP response body from
+// the result of the "verify_email" endpoint of the "user" service.
+func NewVerifyEmailNotImplementedResponseBody(res *goa.ServiceError) *VerifyEmailNotImplementedResponseBody {
+	body := &VerifyEmailNotImplementedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewVerifyEmailInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "verify_email" endpoint of the "user" service.
+func NewVerifyEmailInternalServerErrorResponseBody(res *goa.ServiceError) *VerifyEmailInternalServerErrorResponseBody {
+	body := &VerifyEmailInternalServerErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateUserInput builds a user service create endpoint payload.
+func NewCreateUserInput(body *CreateRequestBody) *user.UserInput {
+	v := &user.UserInput{
+		Username: *body.Username,
+		Password: *body.Password,
+		Email:    body.Email,
+	}
+
+	return v
+}
+
+// NewDeleteUserPayload builds a user service delete endpoint payload.
+func NewDeleteUserPayload(authorization string) *user.DeleteUserPayload {
+	v := &user.DeleteUserPayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewGetMeGetUserPayload builds a user service get_me endpoint payload.
+func NewGetMeGetUserPayload(authorization string) *user.GetUserPayload {
+	v := &user.GetUserPayload{}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewUpdateMeUpdateUserPayload builds a user service update_me endpoint
+// payload.
+func NewUpdateMeUpdateUserPayload(body *UpdateMeRequestBody, authorization string) *user.UpdateUserPayload {
+	v := &user.UpdateUserPayload{
+		DisplayName: body.DisplayName,
+		Locale:      body.Locale,
+		Email:       body.Email,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make(map[string]string, len(body.Attributes))
+		for key, val := range body.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewChangePasswordPayload builds a user service change_password endpoint
+// payload.
+func NewChangePasswordPayload(body *ChangePasswordRequestBody, authorization string) *user.ChangePasswordPayload {
+	v := &user.ChangePasswordPayload{
+		CurrentPassword: *body.CurrentPassword,
+		NewPassword:     *body.NewPassword,
+	}
+	v.Authorization = authorization
+
+	return v
+}
+
+// NewRequestPasswordResetPasswordResetRequest builds a user service
+// request_password_reset endpoint payload.
+func NewRequestPasswordResetPasswordResetRequest(body *RequestPasswordResetRequestBody) *user.PasswordResetRequest {
+	v := &user.PasswordResetRequest{
+		Username: *body.Username,
+	}
+
+	return v
+}
+
+// NewResetPasswordPasswordResetInput builds a user service reset_password
+// endpoint payload.
+func NewResetPasswordPasswordResetInput(body *ResetPasswordRequestBody) *user.PasswordResetInput {
+	v := &user.PasswordResetInput{
+		Token:       *body.Token,
+		NewPassword: *body.NewPassword,
+	}
+
+	return v
+}
+
+// NewRequestEmailVerificationEmailVerificationRequest builds a user service
+// request_email_verification endpoint payload.
+func NewRequestEmailVerificationEmailVerificationRequest(body *RequestEmailVerificationRequestBody) *user.EmailVerificationRequest {
+	v := &user.EmailVerificationRequest{
+		Username: *body.Username,
+	}
+
+	return v
+}
+
+// NewVerifyEmailEmailVerificationInput builds a user service verify_email
+// endpoint payload.
+func NewVerifyEmailEmailVerificationInput(body *VerifyEmailRequestBody) *user.EmailVerificationInput {
+	v := &user.EmailVerificationInput{
+		Token: *body.Token,
+	}
+
+	return v
+}
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.Username == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("username", "body"))
+	}
+	if body.Password == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("password", "body"))
+	}
+	if body.Username != nil {
+		if utf8.RuneCountInString(*body.Username) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", *body.Username, utf8.RuneCountInString(*body.Username), 256, false))
+		}
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateUpdateMeRequestBody runs the validations defined on
+// update_me_request_body
+func ValidateUpdateMeRequestBody(body *UpdateMeRequestBody) (err error) {
+	if body.DisplayName != nil {
+		if utf8.RuneCountInString(*body.DisplayName) > 128 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", *body.DisplayName, utf8.RuneCountInString(*body.DisplayName), 128, false))
+		}
+	}
+	if body.Locale != nil {
+		if utf8.RuneCountInString(*body.Locale) > 35 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.locale", *body.Locale, utf8.RuneCountInString(*body.Locale), 35, false))
+		}
+	}
+	if len(body.Attributes) > 32 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.attributes", body.Attributes, len(body.Attributes), 32, false))
+	}
+	for k, v := range body.Attributes {
+		if utf8.RuneCountInString(k) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.attributes.key", k, utf8.RuneCountInString(k), 1, true))
+		}
+		if utf8.RuneCountInString(k) > 64 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.attributes.key", k, utf8.RuneCountInString(k), 64, false))
+		}
+		if utf8.RuneCountInString(v) > 1024 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.attributes[key]", v, utf8.RuneCountInString(v), 1024, false))
+		}
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateChangePasswordRequestBody runs the validations defined on
+// change_password_request_body
+func ValidateChangePasswordRequestBody(body *ChangePasswordRequestBody) (err error) {
+	if body.CurrentPassword == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("current_password", "body"))
+	}
+	if body.NewPassword == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("new_password", "body"))
+	}
+	return
+}
+
+// ValidateRequestPasswordResetRequestBody runs the validations defined on
+// request_password_reset_request_body
+func ValidateRequestPasswordResetRequestBody(body *RequestPasswordResetRequestBody) (err error) {
+	if body.Username == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("username", "body"))
+	}
+	if body.Username != nil {
+		if utf8.RuneCountInString(*body.Username) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", *body.Username, utf8.RuneCountInString(*body.Username), 256, false))
+		}
+	}
+	return
+}
+
+// ValidateResetPasswordRequestBody runs the validations defined on
+// reset_password_request_body
+func ValidateResetPasswordRequestBody(body *ResetPasswordRequestBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	if body.NewPassword == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("new_password", "body"))
+	}
+	return
+}
+
+// ValidateRequestEmailVerificationRequestBody runs the validations defined on
+// request_email_verification_request_body
+func ValidateRequestEmailVerificationRequestBody(body *RequestEmailVerificationRequestBody) (err error) {
+	if body.Username == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("username", "body"))
+	}
+	if body.Username != nil {
+		if utf8.RuneCountInString(*body.Username) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.username", *body.Username, utf8.RuneCountInString(*body.Username), 256, false))
+		}
+	}
+	return
+}
+
+// ValidateVerifyEmailRequestBody runs the validations defined on
+// verify_email_request_body
+func ValidateVerifyEmailRequestBody(body *VerifyEmailRequestBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
 	}
 	return
 }
@@ -15,20 +15,25 @@ import (
 
 // Client is the "token" service client.
 type Client struct {
-	IssueEndpoint   goa.Endpoint
-	RefreshEndpoint goa.Endpoint
+	IssueEndpoint      goa.Endpoint
+	RefreshEndpoint    goa.Endpoint
+	RevokeEndpoint     goa.Endpoint
+	IntrospectEndpoint goa.Endpoint
 }
 
 // NewClient initializes a "token" service client given the endpoints.
-func NewClient(issue, refresh goa.Endpoint) *Client {
+func NewClient(issue, refresh, revoke, introspect goa.Endpoint) *Client {
 	return &Client{
-		IssueEndpoint:   issue,
-		RefreshEndpoint: refresh,
+		IssueEndpoint:      issue,
+		RefreshEndpoint:    refresh,
+		RevokeEndpoint:     revoke,
+		IntrospectEndpoint: introspect,
 	}
 }
 
 // Issue calls the "issue" endpoint of the "token" service.
 // Issue may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
 //   - error: internal error
@@ -43,6 +48,7 @@ func (c *Client) Issue(ctx context.Context, p *IssueInput) (res *TokenDetail, er
 
 // Refresh calls the "refresh" endpoint of the "token" service.
 // Refresh may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
 //   - error: internal error
@@ -54,3 +60,29 @@ func (c *Client) Refresh(ctx context.Context, p *RefreshInput) (res *TokenDetail
 	}
 	return ires.(*TokenDetail), nil
 }
+
+// Revoke calls the "revoke" endpoint of the "token" service.
+// Revoke may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - error: internal error
+func (c *Client) Revoke(ctx context.Context, p *RevokeInput) (err error) {
+	_, err = c.RevokeEndpoint(ctx, p)
+	return
+}
+
+// Introspect calls the "introspect" endpoint of the "token" service.
+// Introspect may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - error: internal error
+func (c *Client) Introspect(ctx context.Context, p *IntrospectInput) (res *IntrospectionResult, err error) {
+	var ires any
+	ires, err = c.IntrospectEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*IntrospectionResult), nil
+}
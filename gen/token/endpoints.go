@@ -11,19 +11,26 @@ import (
 	"context"
 
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // Endpoints wraps the "token" service endpoints.
 type Endpoints struct {
-	Issue   goa.Endpoint
-	Refresh goa.Endpoint
+	Issue      goa.Endpoint
+	Refresh    goa.Endpoint
+	Revoke     goa.Endpoint
+	Introspect goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "token" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
 	return &Endpoints{
-		Issue:   NewIssueEndpoint(s),
-		Refresh: NewRefreshEndpoint(s),
+		Issue:      NewIssueEndpoint(s),
+		Refresh:    NewRefreshEndpoint(s),
+		Revoke:     NewRevokeEndpoint(s, a.BasicAuth),
+		Introspect: NewIntrospectEndpoint(s, a.BasicAuth),
 	}
 }
 
@@ -31,6 +38,8 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Issue = m(e.Issue)
 	e.Refresh = m(e.Refresh)
+	e.Revoke = m(e.Revoke)
+	e.Introspect = m(e.Introspect)
 }
 
 // NewIssueEndpoint returns an endpoint function that calls the method "issue"
@@ -50,3 +59,41 @@ func NewRefreshEndpoint(s Service) goa.Endpoint {
 		return s.Refresh(ctx, p)
 	}
 }
+
+// NewRevokeEndpoint returns an endpoint function that calls the method
+// "revoke" of service "token".
+func NewRevokeEndpoint(s Service, authBasicFn security.AuthBasicFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeInput)
+		var err error
+		sc := security.BasicScheme{
+			Name:           "client_auth",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authBasicFn(ctx, p.ClientID, p.ClientSecret, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.Revoke(ctx, p)
+	}
+}
+
+// NewIntrospectEndpoint returns an endpoint function that calls the method
+// "introspect" of service "token".
+func NewIntrospectEndpoint(s Service, authBasicFn security.AuthBasicFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*IntrospectInput)
+		var err error
+		sc := security.BasicScheme{
+			Name:           "client_auth",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authBasicFn(ctx, p.ClientID, p.ClientSecret, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Introspect(ctx, p)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"context"
 
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // Service is the token service interface.
@@ -19,6 +20,16 @@ type Service interface {
 	Issue(context.Context, *IssueInput) (res *TokenDetail, err error)
 	// Refresh implements refresh.
 	Refresh(context.Context, *RefreshInput) (res *TokenDetail, err error)
+	// Revoke implements revoke.
+	Revoke(context.Context, *RevokeInput) (err error)
+	// Introspect implements introspect.
+	Introspect(context.Context, *IntrospectInput) (res *IntrospectionResult, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// BasicAuth implements the authorization logic for the Basic security scheme.
+	BasicAuth(ctx context.Context, user, pass string, schema *security.BasicScheme) (context.Context, error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -35,7 +46,46 @@ const ServiceName = "token"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"issue", "refresh"}
+var MethodNames = [4]string{"issue", "refresh", "revoke", "introspect"}
+
+// IntrospectInput is the payload type of the token service introspect method.
+type IntrospectInput struct {
+	// The ID of the client
+	ClientID string
+	// The secret of the client
+	ClientSecret string
+	// The access or refresh token to introspect
+	Token string
+	// The kind of the token
+	TokenTypeHint *string
+}
+
+// IntrospectionResult is the result type of the token service introspect
+// method.
+type IntrospectionResult struct {
+	// Whether the token is currently active
+	Active bool
+	// The type of an access token, Bearer. Omitted for refresh tokens
+	TokenType *string
+	// The ID of the token
+	Jti *string
+	// The subject of the token
+	Sub *string
+	// The issuer of the token
+	Iss *string
+	// The audience of the token
+	Aud []string
+	// The space separated scopes of the token
+	Scope *string
+	// The client the token was issued to
+	ClientID *string
+	// The time the token was issued at, in seconds since the epoch
+	Iat *int64
+	// The time the token expires at, in seconds since the epoch
+	Exp *int64
+	// The custom claims of the token
+	Ext map[string]any
+}
 
 // IssueInput is the payload type of the token service issue method.
 type IssueInput struct {
@@ -43,16 +93,36 @@ type IssueInput struct {
 	Username string
 	// The password of the user
 	Password string
+	// The client the user logs in with, which may have its own token policy
+	ClientID *string
+	// The secret of the client
+	ClientSecret *string
+	// The services the access token is meant for, key-stone itself if omitted
+	Audience []string
+	// The space separated scopes requested, every granted scope if omitted
+	Scope *string
 }
 
 // RefreshInput is the payload type of the token service refresh method.
 type RefreshInput struct {
-	// The access token of the user
-	AccessToken string
+	// The access token of the same session, may be expired
+	AccessToken *string
 	// The refresh token of the user
 	RefreshToken string
 }
 
+// RevokeInput is the payload type of the token service revoke method.
+type RevokeInput struct {
+	// The ID of the client
+	ClientID string
+	// The secret of the client
+	ClientSecret string
+	// The access or refresh token to revoke
+	Token string
+	// The kind of the token
+	TokenTypeHint *string
+}
+
 // TokenDetail is the result type of the token service issue method.
 type TokenDetail struct {
 	// The access token of the user
@@ -63,6 +133,13 @@ type TokenDetail struct {
 	ExpiresIn int
 	// The refresh token of the user
 	RefreshToken string
+	// The space separated scopes granted to the access token
+	Scope *string
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "BadRequest", false, false, false)
 }
 
 // MakeUnauthorized builds a goa.ServiceError from an error.
@@ -15,23 +15,39 @@ import (
 
 // Client is the "user" service client.
 type Client struct {
-	CreateEndpoint goa.Endpoint
-	DeleteEndpoint goa.Endpoint
+	CreateEndpoint                   goa.Endpoint
+	DeleteEndpoint                   goa.Endpoint
+	GetMeEndpoint                    goa.Endpoint
+	UpdateMeEndpoint                 goa.Endpoint
+	ChangePasswordEndpoint           goa.Endpoint
+	RequestPasswordResetEndpoint     goa.Endpoint
+	ResetPasswordEndpoint            goa.Endpoint
+	RequestEmailVerificationEndpoint goa.Endpoint
+	VerifyEmailEndpoint              goa.Endpoint
 }
 
 // NewClient initializes a "user" service client given the endpoints.
-func NewClient(create, delete_ goa.Endpoint) *Client {
+func NewClient(create, delete_, getMe, updateMe, changePassword, requestPasswordReset, resetPassword, requestEmailVerification, verifyEmail goa.Endpoint) *Client {
 	return &Client{
-		CreateEndpoint: create,
-		DeleteEndpoint: delete_,
+		CreateEndpoint:                   create,
+		DeleteEndpoint:                   delete_,
+		GetMeEndpoint:                    getMe,
+		UpdateMeEndpoint:                 updateMe,
+		ChangePasswordEndpoint:           changePassword,
+		RequestPasswordResetEndpoint:     requestPasswordReset,
+		ResetPasswordEndpoint:            resetPassword,
+		RequestEmailVerificationEndpoint: requestEmailVerification,
+		VerifyEmailEndpoint:              verifyEmail,
 	}
 }
 
 // Create calls the "create" endpoint of the "user" service.
 // Create may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "UserAlreadyExists" (type *goa.ServiceError): User Already Exists
 //   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
 //   - error: internal error
 func (c *Client) Create(ctx context.Context, p *UserInput) (err error) {
 	_, err = c.CreateEndpoint(ctx, p)
@@ -40,11 +56,114 @@ func (c *Client) Create(ctx context.Context, p *UserInput) (err error) {
 
 // Delete calls the "delete" endpoint of the "user" service.
 // Delete may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
 //   - "Unauthorized" (type *goa.ServiceError): Unauthorized
 //   - "UserAlreadyExists" (type *goa.ServiceError): User Already Exists
 //   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
 //   - error: internal error
 func (c *Client) Delete(ctx context.Context, p *DeleteUserPayload) (err error) {
 	_, err = c.DeleteEndpoint(ctx, p)
 	return
 }
+
+// GetMe calls the "get_me" endpoint of the "user" service.
+// GetMe may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "UserAlreadyExists" (type *goa.ServiceError): User Already Exists
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) GetMe(ctx context.Context, p *GetUserPayload) (res *UserProfile, err error) {
+	var ires any
+	ires, err = c.GetMeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UserProfile), nil
+}
+
+// UpdateMe calls the "update_me" endpoint of the "user" service.
+// UpdateMe may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "UserAlreadyExists" (type *goa.ServiceError): User Already Exists
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) UpdateMe(ctx context.Context, p *UpdateUserPayload) (res *UserProfile, err error) {
+	var ires any
+	ires, err = c.UpdateMeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UserProfile), nil
+}
+
+// ChangePassword calls the "change_password" endpoint of the "user" service.
+// ChangePassword may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "UserAlreadyExists" (type *goa.ServiceError): User Already Exists
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) ChangePassword(ctx context.Context, p *ChangePasswordPayload) (err error) {
+	_, err = c.ChangePasswordEndpoint(ctx, p)
+	return
+}
+
+// RequestPasswordReset calls the "request_password_reset" endpoint of the
+// "user" service.
+// RequestPasswordReset may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "UserAlreadyExists" (type *goa.ServiceError): User Already Exists
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) RequestPasswordReset(ctx context.Context, p *PasswordResetRequest) (err error) {
+	_, err = c.RequestPasswordResetEndpoint(ctx, p)
+	return
+}
+
+// ResetPassword calls the "reset_password" endpoint of the "user" service.
+// ResetPassword may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "UserAlreadyExists" (type *goa.ServiceError): User Already Exists
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) ResetPassword(ctx context.Context, p *PasswordResetInput) (err error) {
+	_, err = c.ResetPasswordEndpoint(ctx, p)
+	return
+}
+
+// RequestEmailVerification calls the "request_email_verification" endpoint of
+// the "user" service.
+// RequestEmailVerification may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "UserAlreadyExists" (type *goa.ServiceError): User Already Exists
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) RequestEmailVerification(ctx context.Context, p *EmailVerificationRequest) (err error) {
+	_, err = c.RequestEmailVerificationEndpoint(ctx, p)
+	return
+}
+
+// VerifyEmail calls the "verify_email" endpoint of the "user" service.
+// VerifyEmail may return the following errors:
+//   - "BadRequest" (type *goa.ServiceError): Bad Request
+//   - "Unauthorized" (type *goa.ServiceError): Unauthorized
+//   - "UserAlreadyExists" (type *goa.ServiceError): User Already Exists
+//   - "InternalServerError" (type *goa.ServiceError): Internal Server Error
+//   - "NotImplemented" (type *goa.ServiceError): Not Implemented
+//   - error: internal error
+func (c *Client) VerifyEmail(ctx context.Context, p *EmailVerificationInput) (err error) {
+	_, err = c.VerifyEmailEndpoint(ctx, p)
+	return
+}
@@ -15,15 +15,29 @@ import (
 
 // Endpoints wraps the "user" service endpoints.
 type Endpoints struct {
-	Create goa.Endpoint
-	Delete goa.Endpoint
+	Create                   goa.Endpoint
+	Delete                   goa.Endpoint
+	GetMe                    goa.Endpoint
+	UpdateMe                 goa.Endpoint
+	ChangePassword           goa.Endpoint
+	RequestPasswordReset     goa.Endpoint
+	ResetPassword            goa.Endpoint
+	RequestEmailVerification goa.Endpoint
+	VerifyEmail              goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "user" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		Create: NewCreateEndpoint(s),
-		Delete: NewDeleteEndpoint(s),
+		Create:                   NewCreateEndpoint(s),
+		Delete:                   NewDeleteEndpoint(s),
+		GetMe:                    NewGetMeEndpoint(s),
+		UpdateMe:                 NewUpdateMeEndpoint(s),
+		ChangePassword:           NewChangePasswordEndpoint(s),
+		RequestPasswordReset:     NewRequestPasswordResetEndpoint(s),
+		ResetPassword:            NewResetPasswordEndpoint(s),
+		RequestEmailVerification: NewRequestEmailVerificationEndpoint(s),
+		VerifyEmail:              NewVerifyEmailEndpoint(s),
 	}
 }
 
@@ -31,6 +45,13 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Create = m(e.Create)
 	e.Delete = m(e.Delete)
+	e.GetMe = m(e.GetMe)
+	e.UpdateMe = m(e.UpdateMe)
+	e.ChangePassword = m(e.ChangePassword)
+	e.RequestPasswordReset = m(e.RequestPasswordReset)
+	e.ResetPassword = m(e.ResetPassword)
+	e.RequestEmailVerification = m(e.RequestEmailVerification)
+	e.VerifyEmail = m(e.VerifyEmail)
 }
 
 // NewCreateEndpoint returns an endpoint function that calls the method
@@ -50,3 +71,66 @@ func NewDeleteEndpoint(s Service) goa.Endpoint {
 		return nil, s.Delete(ctx, p)
 	}
 }
+
+// NewGetMeEndpoint returns an endpoint function that calls the method "get_me"
+// of service "user".
+func NewGetMeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetUserPayload)
+		return s.GetMe(ctx, p)
+	}
+}
+
+// NewUpdateMeEndpoint returns an endpoint function that calls the method
+// "update_me" of service "user".
+func NewUpdateMeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateUserPayload)
+		return s.UpdateMe(ctx, p)
+	}
+}
+
+// NewChangePasswordEndpoint returns an endpoint function that calls the method
+// "change_password" of service "user".
+func NewChangePasswordEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ChangePasswordPayload)
+		return nil, s.ChangePassword(ctx, p)
+	}
+}
+
+// NewRequestPasswordResetEndpoint returns an endpoint function that calls the
+// method "request_password_reset" of service "user".
+func NewRequestPasswordResetEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PasswordResetRequest)
+		return nil, s.RequestPasswordReset(ctx, p)
+	}
+}
+
+// NewResetPasswordEndpoint returns an endpoint function that calls the method
+// "reset_password" of service "user".
+func NewResetPasswordEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PasswordResetInput)
+		return nil, s.ResetPassword(ctx, p)
+	}
+}
+
+// NewRequestEmailVerificationEndpoint returns an endpoint function that calls
+// the method "request_email_verification" of service "user".
+func NewRequestEmailVerificationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*EmailVerificationRequest)
+		return nil, s.RequestEmailVerification(ctx, p)
+	}
+}
+
+// NewVerifyEmailEndpoint returns an endpoint function that calls the method
+// "verify_email" of service "user".
+func NewVerifyEmailEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*EmailVerificationInput)
+		return nil, s.VerifyEmail(ctx, p)
+	}
+}
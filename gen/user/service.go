@@ -19,6 +19,20 @@ type Service interface {
 	Create(context.Context, *UserInput) (err error)
 	// Delete implements delete.
 	Delete(context.Context, *DeleteUserPayload) (err error)
+	// GetMe implements get_me.
+	GetMe(context.Context, *GetUserPayload) (res *UserProfile, err error)
+	// UpdateMe implements update_me.
+	UpdateMe(context.Context, *UpdateUserPayload) (res *UserProfile, err error)
+	// ChangePassword implements change_password.
+	ChangePassword(context.Context, *ChangePasswordPayload) (err error)
+	// RequestPasswordReset implements request_password_reset.
+	RequestPasswordReset(context.Context, *PasswordResetRequest) (err error)
+	// ResetPassword implements reset_password.
+	ResetPassword(context.Context, *PasswordResetInput) (err error)
+	// RequestEmailVerification implements request_email_verification.
+	RequestEmailVerification(context.Context, *EmailVerificationRequest) (err error)
+	// VerifyEmail implements verify_email.
+	VerifyEmail(context.Context, *EmailVerificationInput) (err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -35,7 +49,18 @@ const ServiceName = "user"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"create", "delete"}
+var MethodNames = [9]string{"create", "delete", "get_me", "update_me", "change_password", "request_password_reset", "reset_password", "request_email_verification", "verify_email"}
+
+// ChangePasswordPayload is the payload type of the user service
+// change_password method.
+type ChangePasswordPayload struct {
+	// The payload of the user
+	Authorization string
+	// The password the user has now
+	CurrentPassword string
+	// The password replacing it, checked against the password policy
+	NewPassword string
+}
 
 // DeleteUserPayload is the payload type of the user service delete method.
 type DeleteUserPayload struct {
@@ -43,12 +68,90 @@ type DeleteUserPayload struct {
 	Authorization string
 }
 
+// EmailVerificationInput is the payload type of the user service verify_email
+// method.
+type EmailVerificationInput struct {
+	// The verification token mailed to the user
+	Token string
+}
+
+// EmailVerificationRequest is the payload type of the user service
+// request_email_verification method.
+type EmailVerificationRequest struct {
+	// The user whose email is not verified yet
+	Username string
+}
+
+// GetUserPayload is the payload type of the user service get_me method.
+type GetUserPayload struct {
+	// The payload of the user
+	Authorization string
+}
+
+// PasswordResetInput is the payload type of the user service reset_password
+// method.
+type PasswordResetInput struct {
+	// The reset token mailed to the user
+	Token string
+	// The new password, checked against the password policy
+	NewPassword string
+}
+
+// PasswordResetRequest is the payload type of the user service
+// request_password_reset method.
+type PasswordResetRequest struct {
+	// The user who forgot the password
+	Username string
+}
+
+// UpdateUserPayload is the payload type of the user service update_me method.
+type UpdateUserPayload struct {
+	// The payload of the user
+	Authorization string
+	// The name the user is shown as, unchanged if omitted
+	DisplayName *string
+	// The BCP 47 language tag the user prefers, unchanged if omitted
+	Locale *string
+	// The key/value pairs of the applications, replacing every attribute,
+	// unchanged if omitted
+	Attributes map[string]string
+	// A new email, unverified until the user verifies it, unchanged if omitted
+	Email *string
+}
+
 // UserInput is the payload type of the user service create method.
 type UserInput struct {
-	// The name of the user
+	// The name of the user, compared case-insensitively
 	Username string
 	// The password of the user
 	Password string
+	// The address password resets and verification tokens are sent to
+	Email *string
+}
+
+// UserProfile is the result type of the user service get_me method.
+type UserProfile struct {
+	// The name of the user
+	Username string
+	// The email of the user
+	Email *string
+	// Whether the user verified the email
+	EmailVerified bool
+	// The name the user is shown as
+	DisplayName *string
+	// The BCP 47 language tag the user prefers
+	Locale *string
+	// The key/value pairs of the applications
+	Attributes map[string]string
+	// The time the user was created, unknown for users of older versions
+	CreatedAt *string
+	// The time the user or an admin last changed the profile or the password
+	UpdatedAt *string
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "BadRequest", false, false, false)
 }
 
 // MakeUnauthorized builds a goa.ServiceError from an error.
@@ -65,3 +168,8 @@ func MakeUserAlreadyExists(err error) *goa.ServiceError {
 func MakeInternalServerError(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "InternalServerError", false, false, false)
 }
+
+// MakeNotImplemented builds a goa.ServiceError from an error.
+func MakeNotImplemented(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "NotImplemented", false, false, false)
+}
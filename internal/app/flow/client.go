@@ -0,0 +1,6 @@
+package flow
+
+type Client struct {
+	ID     string
+	Secret string
+}
@@ -3,4 +3,28 @@ package flow
 type Credential struct {
 	Username string
 	Password string
+	// Email is optional, it is where password resets and verification tokens are sent to
+	Email string
+}
+
+// ImportedUser is a user taken over from another system with the password hash of that system.
+// Email is optional, EmailVerified trusts the other system to have verified it.
+type ImportedUser struct {
+	Username      string
+	PasswordHash  string
+	Email         string
+	EmailVerified bool
+}
+
+// PasswordChange replaces the password of the user of the access token.
+type PasswordChange struct {
+	Token           string
+	CurrentPassword string
+	NewPassword     string
+}
+
+// PasswordReset sets a new password with the reset token mailed to the user.
+type PasswordReset struct {
+	Token       string
+	NewPassword string
 }
@@ -5,9 +5,26 @@ import "errors"
 var (
 	ErrNotImplemented = errors.New("not implemented")
 
-	ErrUserAlreadyExists = errors.New("user already exists")
-	ErrUserNotFound      = errors.New("user not found")
-	ErrUserUnauthorized  = errors.New("user unauthorized")
+	ErrUserAlreadyExists   = errors.New("user already exists")
+	ErrUserNotFound        = errors.New("user not found")
+	ErrUserUnauthorized    = errors.New("user unauthorized")
+	ErrUsernameInvalid     = errors.New("username is invalid")
+	ErrPasswordInvalid     = errors.New("password is invalid")
+	ErrPasswordBreached    = errors.New("password appears in a data breach")
+	ErrPasswordHashUnknown = errors.New("password hash is unknown")
+	ErrEmailInvalid        = errors.New("email is invalid")
+	ErrEmailNotVerified    = errors.New("email is not verified")
+	ErrProfileInvalid      = errors.New("profile is invalid")
+	ErrUserLocked          = errors.New("user is locked")
+	ErrUserDisabled        = errors.New("user is disabled")
+	ErrForbidden           = errors.New("forbidden")
 
-	ErrTokenInvalid = errors.New("token is invalid")
+	ErrClientAlreadyExists = errors.New("client already exists")
+	ErrClientUnauthorized  = errors.New("client unauthorized")
+
+	ErrTokenInvalid    = errors.New("token is invalid")
+	ErrTokenReused     = errors.New("token is reused")
+	ErrTokenMismatched = errors.New("token is mismatched")
+
+	ErrAudienceNotAllowed = errors.New("audience not allowed")
 )
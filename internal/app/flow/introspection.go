@@ -0,0 +1,19 @@
+package flow
+
+import "time"
+
+// Introspection describes a token as in RFC 7662. Inactive tokens carry no other information.
+// TokenType is TokenTypeBearer for access tokens and empty for refresh tokens, which have no type.
+type Introspection struct {
+	Active    bool
+	TokenType string
+	TokenID   string
+	Subject   string
+	Issuer    string
+	Audience  []string
+	Scope     string
+	ClientID  string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	Claims    map[string]any
+}
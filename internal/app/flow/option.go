@@ -0,0 +1,148 @@
+package flow
+
+import (
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/breachchecker"
+	"github.com/neatflowcv/key-stone/internal/pkg/claimsprovider"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/grantprovider"
+	"github.com/neatflowcv/key-stone/internal/pkg/mailer"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository"
+)
+
+type Option func(*Service)
+
+// WithClaimsProvider adds the custom claims of the provider to every access token.
+func WithClaimsProvider(provider claimsprovider.Provider) Option {
+	return func(s *Service) {
+		s.claims = provider
+	}
+}
+
+// WithGrantProvider decides the audiences and scopes users may request tokens for.
+// Without a grant provider tokens have neither an audience nor a scope.
+func WithGrantProvider(provider grantprovider.Provider) Option {
+	return func(s *Service) {
+		s.grants = provider
+	}
+}
+
+// WithUsernamePolicy replaces the default username policy.
+func WithUsernamePolicy(policy *domain.UsernamePolicy) Option {
+	return func(s *Service) {
+		s.usernames = policy
+	}
+}
+
+// WithPasswordPolicy replaces the default password policy.
+func WithPasswordPolicy(policy *domain.PasswordPolicy) Option {
+	return func(s *Service) {
+		s.passwords = policy
+	}
+}
+
+// WithBreachChecker rejects new passwords that appear in the breached passwords of the checker.
+func WithBreachChecker(checker breachchecker.Checker) Option {
+	return func(s *Service) {
+		s.breaches = checker
+	}
+}
+
+// WithPasswordReset lets users reset a forgotten password with a token mailed to them, valid for lifetime.
+// The link of the mail has "{token}" replaced by the token, without a link the mail holds the bare token.
+func WithPasswordReset(
+	tokens resettokenrepository.Repository,
+	sender mailer.Mailer,
+	lifetime time.Duration,
+	link string,
+) Option {
+	return func(s *Service) {
+		s.resets = tokens
+		s.mailer = sender
+		s.resetLifetime = lifetime
+		s.resetLink = link
+	}
+}
+
+// WithEmailVerification mails a verification token, valid for lifetime, to the email of new users.
+// The link of the mail has "{token}" replaced by the token, without a link the mail holds the bare token.
+func WithEmailVerification(
+	tokens verificationtokenrepository.Repository,
+	sender mailer.Mailer,
+	lifetime time.Duration,
+	link string,
+) Option {
+	return func(s *Service) {
+		s.verifications = tokens
+		s.mailer = sender
+		s.verificationLifetime = lifetime
+		s.verificationLink = link
+	}
+}
+
+// WithVerifiedEmailRequired refuses tokens to users until they verified their email, and an email to new users.
+// Users without an email, e.g. imported ones, still get tokens, so they can set one.
+// It needs WithEmailVerification, or nobody can verify an email.
+func WithVerifiedEmailRequired() Option {
+	return func(s *Service) {
+		s.requireVerifiedEmail = true
+	}
+}
+
+// WithProfileClaims adds the profile of the user to every access token, as the "name", "locale"
+// and "attributes" claims. Claims of the claims provider take precedence.
+func WithProfileClaims() Option {
+	return func(s *Service) {
+		s.profileClaims = true
+	}
+}
+
+// WithLockoutPolicy locks users out for a while after too many failed logins in a row.
+func WithLockoutPolicy(policy *domain.LockoutPolicy) Option {
+	return func(s *Service) {
+		s.lockout = policy
+	}
+}
+
+// WithAdminAccess replaces the role and the scope that allow an access token to manage other users.
+// Either one is enough, an empty role or scope allows nobody. Without the option both are "admin".
+// The roles of a user are read from the "roles" custom claim, so the role needs a claims provider.
+func WithAdminAccess(role, scope string) Option {
+	return func(s *Service) {
+		s.adminRole = role
+		s.adminScope = scope
+	}
+}
+
+// WithAudience replaces the audience of key-stone itself. The endpoints of users and admins only accept
+// access tokens issued for it, tokens requested without an audience are. Without the option it is "key-stone".
+func WithAudience(audience string) Option {
+	return func(s *Service) {
+		s.audience = audience
+	}
+}
+
+// WithTokenPolicy replaces the default token policy of the deployment.
+func WithTokenPolicy(policy *domain.TokenPolicy) Option {
+	return func(s *Service) {
+		s.policy = policy
+	}
+}
+
+// WithClientTokenPolicy overrides the token policy for sessions of the client.
+func WithClientTokenPolicy(clientID string, policy *domain.TokenPolicy) Option {
+	return func(s *Service) {
+		s.clientPolicies[clientID] = policy
+	}
+}
+
+// WithRoleTokenPolicy overrides the token policy for users with the role.
+// The roles of a user are read from the "roles" custom claim, so it needs a claims provider.
+// A role policy takes precedence over a client policy.
+func WithRoleTokenPolicy(role string, policy *domain.TokenPolicy) Option {
+	return func(s *Service) {
+		s.rolePolicies[role] = policy
+	}
+}
@@ -0,0 +1,13 @@
+package flow
+
+type PublicKey struct {
+	KeyType   string
+	Use       string
+	Algorithm string
+	KeyID     string
+	N         string
+	E         string
+	Curve     string
+	X         string
+	Y         string
+}
@@ -2,47 +2,202 @@ package flow
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"log"
+	"maps"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/neatflowcv/key-stone/internal/pkg/breachchecker"
+	"github.com/neatflowcv/key-stone/internal/pkg/claimsprovider"
+	"github.com/neatflowcv/key-stone/internal/pkg/clientrepository"
 	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
 	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/grantprovider"
 	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+	"github.com/neatflowcv/key-stone/internal/pkg/mailer"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
 	"github.com/neatflowcv/key-stone/internal/pkg/tokengenerator"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository"
+	"golang.org/x/text/language"
 )
 
 type Service struct {
-	repo   credentialrepository.Repository
-	hasher hasher.Hasher
-	pubGen tokengenerator.Generator
-	priGen tokengenerator.Generator
+	repo          credentialrepository.Repository
+	clients       clientrepository.Repository
+	sessions      sessionrepository.Repository
+	revocations   revocationrepository.Repository
+	hasher        hasher.Hasher
+	pubGen        tokengenerator.Generator
+	priGen        tokengenerator.Generator
+	claims        claimsprovider.Provider
+	grants        grantprovider.Provider
+	breaches      breachchecker.Checker
+	resets        resettokenrepository.Repository
+	verifications verificationtokenrepository.Repository
+	mailer        mailer.Mailer
+	usernames     *domain.UsernamePolicy
+	passwords     *domain.PasswordPolicy
+	lockout       *domain.LockoutPolicy
+
+	policy         *domain.TokenPolicy
+	clientPolicies map[string]*domain.TokenPolicy
+	rolePolicies   map[string]*domain.TokenPolicy
+
+	resetLifetime time.Duration
+	resetLink     string
+
+	verificationLifetime time.Duration
+	verificationLink     string
+	requireVerifiedEmail bool
+	profileClaims        bool
+
+	audience   string
+	adminRole  string
+	adminScope string
 }
 
 func NewService(
 	repo credentialrepository.Repository,
+	clients clientrepository.Repository,
+	sessions sessionrepository.Repository,
+	revocations revocationrepository.Repository,
 	hasher hasher.Hasher,
 	pubGen tokengenerator.Generator,
 	priGen tokengenerator.Generator,
+	opts ...Option,
 ) *Service {
-	return &Service{
-		repo:   repo,
-		hasher: hasher,
-		pubGen: pubGen,
-		priGen: priGen,
+	service := &Service{
+		repo:          repo,
+		clients:       clients,
+		sessions:      sessions,
+		revocations:   revocations,
+		hasher:        hasher,
+		pubGen:        pubGen,
+		priGen:        priGen,
+		claims:        nil,
+		grants:        nil,
+		breaches:      nil,
+		resets:        nil,
+		verifications: nil,
+		mailer:        nil,
+		usernames:     domain.NewUsernamePolicy(),
+		passwords:     domain.NewPasswordPolicy(),
+		lockout:       domain.NewLockoutPolicy(),
+
+		policy:         domain.NewTokenPolicy(),
+		clientPolicies: make(map[string]*domain.TokenPolicy),
+		rolePolicies:   make(map[string]*domain.TokenPolicy),
+
+		resetLifetime: 0,
+		resetLink:     "",
+
+		verificationLifetime: 0,
+		verificationLink:     "",
+		requireVerifiedEmail: false,
+		profileClaims:        false,
+
+		audience:   "key-stone",
+		adminRole:  "admin",
+		adminScope: "admin",
 	}
+
+	for _, opt := range opts {
+		opt(service)
+	}
+
+	return service
 }
 
 // CreateUser creates a new user
+// The username is stored in its normalized form, see domain.UsernamePolicy.
+// If email verification is configured, a verification token is mailed to the email. A failed mail does not
+// fail the creation, the user can ask for another one with RequestEmailVerification.
 // Returns:
+//   - ErrUsernameInvalid if the username is not allowed by the username policy
+//   - ErrPasswordInvalid if the password is not allowed by the password policy or was breached
+//   - ErrEmailInvalid if the email is no mail address, or missing while a verified email is required
 //   - ErrUserAlreadyExists if the user already exists
 func (s *Service) CreateUser(ctx context.Context, credential *Credential) error {
+	username, err := s.usernames.Validate(credential.Username)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUsernameInvalid, err)
+	}
+
+	err = s.validatePassword(ctx, username, credential.Password)
+	if err != nil {
+		return err
+	}
+
+	email, err := s.validateEmail(credential.Email)
+	if err != nil {
+		return err
+	}
+
 	hashedPassword, err := s.hasher.Hash(credential.Password)
 	if err != nil {
 		return fmt.Errorf("failed to hash password: %w", err)
 	}
 
-	cred := domain.NewCredential(credential.Username, hashedPassword)
+	// tokens of an earlier user of the same name are not valid for this one,
+	// issued at is counted in whole seconds, so the cut-off is too
+	cred := domain.NewCredential(username, hashedPassword).
+		WithEmail(email).
+		WithTokensValidAfter(time.Now().Truncate(time.Second))
+
+	err = s.repo.CreateCredential(ctx, cred)
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialAlreadyExists, ErrUserAlreadyExists)
+	}
+
+	if s.verifications != nil && email != "" {
+		err = s.sendVerification(ctx, cred, time.Now())
+		if err != nil {
+			log.Printf("failed to send verification mail to %q: %v", cred.Username(), err)
+		}
+	}
+
+	return nil
+}
+
+// ImportUser creates a new user with a password hash of another system
+// The hash is kept until the user logs in, then it is replaced by a hash of the current algorithm.
+// No verification token is mailed to an unverified email, the user can ask for one with RequestEmailVerification.
+// Returns:
+//   - ErrUsernameInvalid if the username is not allowed by the username policy
+//   - ErrPasswordHashUnknown if the hasher does not recognize the password hash
+//   - ErrEmailInvalid if the email is no mail address
+//   - ErrUserAlreadyExists if the user already exists
+func (s *Service) ImportUser(ctx context.Context, user *ImportedUser) error {
+	username, err := s.usernames.Validate(user.Username)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUsernameInvalid, err)
+	}
+
+	if !s.hasher.Recognizes(user.PasswordHash) {
+		return ErrPasswordHashUnknown
+	}
+
+	// users without an email may log in even if a verified email is required, to set one
+	email, err := normalizeEmail(user.Email)
+	if err != nil {
+		return err
+	}
+
+	cred := domain.NewCredential(username, user.PasswordHash).
+		WithEmail(email).
+		WithEmailVerified(email != "" && user.EmailVerified).
+		WithTokensValidAfter(time.Now().Truncate(time.Second))
 
 	err = s.repo.CreateCredential(ctx, cred)
 	if err != nil {
@@ -53,16 +208,17 @@ func (s *Service) CreateUser(ctx context.Context, credential *Credential) error
 }
 
 // DeleteUser deletes the user
+// The token and every session of the user are revoked, so nothing issued to the user outlives it.
 // Returns:
 //   - ErrTokenInvalid if the token is invalid
 //   - ErrUserNotFound if the user does not exist
 func (s *Service) DeleteUser(ctx context.Context, token string) error {
-	username, err := s.pubGen.ParseToken(token, time.Now())
+	claims, err := s.authenticate(ctx, token, time.Now())
 	if err != nil {
-		return casError(err, tokengenerator.ErrTokenInvalid, ErrTokenInvalid)
+		return err
 	}
 
-	cred, err := s.repo.GetCredential(ctx, username)
+	cred, err := s.repo.GetCredential(ctx, claims.Subject())
 	if err != nil {
 		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
 	}
@@ -72,71 +228,1577 @@ func (s *Service) DeleteUser(ctx context.Context, token string) error {
 		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
 	}
 
+	err = s.revokeToken(ctx, claims)
+	if err != nil {
+		return err
+	}
+
+	return s.revokeSessions(ctx, cred.Username())
+}
+
+// ChangePassword replaces the password of the user of the token
+// Every token issued before the change is rejected afterwards and every session is revoked,
+// so other devices have to log in with the new password. Resource servers that verify access tokens
+// themselves keep accepting them until they expire, unless they introspect them.
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrUserNotFound if the user does not exist
+//   - ErrUserUnauthorized if the current password is wrong
+//   - ErrPasswordInvalid if the new password is not allowed by the password policy or was breached
+func (s *Service) ChangePassword(ctx context.Context, change *PasswordChange) error {
+	now := time.Now()
+
+	claims, err := s.authenticate(ctx, change.Token, now)
+	if err != nil {
+		return err
+	}
+
+	cred, err := s.repo.GetCredential(ctx, claims.Subject())
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	err = s.hasher.Compare(change.CurrentPassword, cred.Password())
+	if err != nil {
+		return casError(err, hasher.ErrMismatched, ErrUserUnauthorized)
+	}
+
+	err = s.validatePassword(ctx, cred.Username(), change.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.hasher.Hash(change.NewPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// issued at is counted in whole seconds, tokens issued in the second of the change stay valid,
+	// so the token of the request is revoked on its own
+	_, err = s.modifyCredential(ctx, cred.Username(), func(stored *domain.Credential) (*domain.Credential, error) {
+		// the current password was verified against this hash, a concurrent change of it wins
+		if stored.Password() != cred.Password() {
+			return nil, ErrUserUnauthorized
+		}
+
+		return stored.WithPassword(hashedPassword).WithTokensValidAfter(now.Truncate(time.Second)), nil
+	})
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	s.touchUser(ctx, cred.Username())
+
+	err = s.revokeToken(ctx, claims)
+	if err != nil {
+		return err
+	}
+
+	return s.revokeSessions(ctx, cred.Username())
+}
+
+// GetUser returns the profile of the user of the token
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrUserNotFound if the user does not exist
+func (s *Service) GetUser(ctx context.Context, token string) (*User, error) {
+	claims, err := s.authenticate(ctx, token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getUser(ctx, claims.Subject())
+}
+
+// UpdateUser changes the profile of the user of the token and returns the changed profile
+// The locale is stored in its canonical form, e.g. "en-us" becomes "en-US".
+// A new email is unverified and, if email verification is configured, mailed a verification token.
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrUserNotFound if the user does not exist
+//   - ErrProfileInvalid if a field is too long, the locale is no language tag or there are too many attributes
+//   - ErrEmailInvalid if the email is no mail address, or empty while a verified email is required
+func (s *Service) UpdateUser(ctx context.Context, update *UserUpdate) (*User, error) {
+	claims, err := s.authenticate(ctx, update.Token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var email string
+
+	if update.Email != nil {
+		email, err = s.validateEmail(*update.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := s.repo.GetUser(ctx, claims.Subject())
+	if err != nil {
+		return nil, casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	if update.DisplayName != nil {
+		user = user.WithDisplayName(*update.DisplayName)
+	}
+
+	if update.Locale != nil {
+		user = user.WithLocale(*update.Locale)
+	}
+
+	if update.Attributes != nil {
+		user = user.WithAttributes(update.Attributes)
+	}
+
+	user, err = normalizeProfile(user)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Email != nil {
+		err = s.changeEmail(ctx, user.Username(), email, false)
+		if err != nil {
+			return nil, casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+		}
+	}
+
+	err = s.repo.UpdateUser(ctx, user)
+	if err != nil {
+		return nil, casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	return s.getUser(ctx, user.Username())
+}
+
+// RequestPasswordReset mails a single-use reset token to the user
+// Nothing tells whether the user exists or has an email, so the method cannot be used to probe usernames.
+// For the same reason a failure to mail the token is logged instead of returned.
+// Returns:
+//   - ErrNotImplemented if password reset is not configured
+func (s *Service) RequestPasswordReset(ctx context.Context, username string) error {
+	if s.resets == nil {
+		return ErrNotImplemented
+	}
+
+	cred, err := s.repo.GetCredential(ctx, s.usernames.Normalize(username))
+	if err != nil {
+		if errors.Is(err, credentialrepository.ErrCredentialNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get credential: %w", err)
+	}
+
+	if cred.Email() == "" {
+		log.Printf("password reset of %q requested, but the user has no email", cred.Username())
+
+		return nil
+	}
+
+	err = s.sendReset(ctx, cred, time.Now())
+	if err != nil {
+		log.Printf("failed to send reset mail to %q: %v", cred.Username(), err)
+	}
+
+	return nil
+}
+
+// ResetPassword sets a new password with a reset token from RequestPasswordReset
+// The token works once. Like a password change, the reset rejects every token issued before it and
+// revokes every session.
+// Returns:
+//   - ErrNotImplemented if password reset is not configured
+//   - ErrTokenInvalid if the reset token is unknown, used, expired or older than the last password change
+//   - ErrPasswordInvalid if the new password is not allowed by the password policy or was breached
+func (s *Service) ResetPassword(ctx context.Context, reset *PasswordReset) error {
+	if s.resets == nil {
+		return ErrNotImplemented
+	}
+
+	now := time.Now()
+	id := secretID(reset.Token)
+
+	token, err := s.resets.GetResetToken(ctx, id, now)
+	if err != nil {
+		return casError(err, resettokenrepository.ErrResetTokenNotFound, ErrTokenInvalid)
+	}
+
+	cred, err := s.repo.GetCredential(ctx, token.Username())
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrTokenInvalid)
+	}
+
+	if token.CreatedAt().Before(cred.TokensValidAfter()) {
+		return ErrTokenInvalid
+	}
+
+	// a rejected password leaves the token usable for another try
+	err = s.validatePassword(ctx, cred.Username(), reset.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	err = s.resets.DeleteResetToken(ctx, id)
+	if err != nil {
+		return casError(err, resettokenrepository.ErrResetTokenNotFound, ErrTokenInvalid)
+	}
+
+	hashedPassword, err := s.hasher.Hash(reset.NewPassword)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	_, err = s.modifyCredential(ctx, cred.Username(), func(stored *domain.Credential) (*domain.Credential, error) {
+		if token.CreatedAt().Before(stored.TokensValidAfter()) {
+			return nil, ErrTokenInvalid
+		}
+
+		return stored.WithPassword(hashedPassword).WithTokensValidAfter(now.Truncate(time.Second)), nil
+	})
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrTokenInvalid)
+	}
+
+	s.touchUser(ctx, cred.Username())
+
+	return s.revokeSessions(ctx, cred.Username())
+}
+
+// RequestEmailVerification mails another verification token to the user, e.g. when the first one expired
+// Like RequestPasswordReset, nothing tells whether the user exists, has an email or verified it already.
+// Returns:
+//   - ErrNotImplemented if email verification is not configured
+func (s *Service) RequestEmailVerification(ctx context.Context, username string) error {
+	if s.verifications == nil {
+		return ErrNotImplemented
+	}
+
+	cred, err := s.repo.GetCredential(ctx, s.usernames.Normalize(username))
+	if err != nil {
+		if errors.Is(err, credentialrepository.ErrCredentialNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get credential: %w", err)
+	}
+
+	if cred.Email() == "" || cred.EmailVerified() {
+		return nil
+	}
+
+	return s.sendVerification(ctx, cred, time.Now())
+}
+
+// VerifyEmail marks the email of the user as verified with a verification token mailed to it
+// The token works once, and only as long as the user keeps the email it was sent to.
+// Returns:
+//   - ErrNotImplemented if email verification is not configured
+//   - ErrTokenInvalid if the verification token is unknown, used, expired or for another email
+func (s *Service) VerifyEmail(ctx context.Context, token string) error {
+	if s.verifications == nil {
+		return ErrNotImplemented
+	}
+
+	id := secretID(token)
+
+	verification, err := s.verifications.GetVerificationToken(ctx, id, time.Now())
+	if err != nil {
+		return casError(err, verificationtokenrepository.ErrVerificationTokenNotFound, ErrTokenInvalid)
+	}
+
+	cred, err := s.repo.GetCredential(ctx, verification.Username())
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrTokenInvalid)
+	}
+
+	if cred.Email() != verification.Email() {
+		return ErrTokenInvalid
+	}
+
+	err = s.verifications.DeleteVerificationToken(ctx, id)
+	if err != nil {
+		return casError(err, verificationtokenrepository.ErrVerificationTokenNotFound, ErrTokenInvalid)
+	}
+
+	if cred.EmailVerified() {
+		return nil
+	}
+
+	_, err = s.modifyCredential(ctx, cred.Username(), func(stored *domain.Credential) (*domain.Credential, error) {
+		if stored.Email() != verification.Email() {
+			return nil, ErrTokenInvalid
+		}
+
+		return stored.WithEmailVerified(true), nil
+	})
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrTokenInvalid)
+	}
+
 	return nil
 }
 
 // CreateToken creates a new token
+// A password hash of an outdated algorithm or with weaker parameters is upgraded on the way.
+// The client is optional. If given, the session belongs to the client and follows its token policy.
+// The request is optional. Without it, or without an audience, the tokens are meant for key-stone itself.
+// Without a scope they have every granted scope.
 // Returns:
 //   - ErrUserNotFound if the user does not exist
+//   - ErrUserLocked if the user is locked out after too many failed logins
 //   - ErrUserUnauthorized if the user is unauthorized
-func (s *Service) CreateToken(ctx context.Context, credential *Credential) (*TokenSetOutput, error) {
-	cred, err := s.repo.GetCredential(ctx, credential.Username)
+//   - ErrUserDisabled if the user is disabled
+//   - ErrEmailNotVerified if a verified email is required and the email of the user is not verified yet
+//   - ErrClientUnauthorized if the client does not exist or the secret is wrong
+//   - ErrAudienceNotAllowed if an audience is not granted to the user
+func (s *Service) CreateToken(
+	ctx context.Context,
+	credential *Credential,
+	client *Client,
+	request *TokenRequest,
+) (*TokenSetOutput, error) {
+	cred, err := s.repo.GetCredential(ctx, s.usernames.Normalize(credential.Username))
 	if err != nil {
 		return nil, casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
 	}
 
+	// refused before the password is compared, so a right guess during the lockout tells nothing
+	if cred.IsLocked(time.Now()) {
+		return nil, ErrUserLocked
+	}
+
 	err = s.hasher.Compare(credential.Password, cred.Password())
 	if err != nil {
-		return nil, casError(err, hasher.ErrMismatched, ErrUserUnauthorized)
+		if errors.Is(err, hasher.ErrMismatched) {
+			s.failLogin(ctx, cred)
+
+			return nil, ErrUserUnauthorized
+		}
+
+		return nil, err
+	}
+
+	cred = s.passLogin(ctx, cred)
+	s.rehash(ctx, cred, credential.Password)
+
+	// checked after the password, so only the user learns that the account is disabled or the email unverified
+	if cred.Disabled() {
+		return nil, ErrUserDisabled
+	}
+
+	// users without an email, e.g. imported ones, are let in to set one
+	if s.requireVerifiedEmail && cred.Email() != "" && !cred.EmailVerified() {
+		return nil, ErrEmailNotVerified
+	}
+
+	var clientID string
+
+	if client != nil {
+		err = s.AuthenticateClient(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		clientID = client.ID
+	}
+
+	if request == nil {
+		request = &TokenRequest{Audience: nil, Scope: nil}
+	}
+
+	audience := request.Audience
+	if audience == nil {
+		audience = []string{s.audience}
+	}
+
+	grant, err := s.grant(ctx, cred.Username())
+	if err != nil {
+		return nil, err
+	}
+
+	if !grant.AllowsAudience(audience...) {
+		return nil, ErrAudienceNotAllowed
+	}
+
+	custom, err := s.customClaims(ctx, cred.Username())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	policy := s.tokenPolicy(clientID, custom)
+	expiresAt := policy.RefreshTokenExpiresAt(now, now)
+	session := domain.NewSession(newID(), cred.Username(), clientID, newID(), now, expiresAt).
+		WithGrant(audience, grant.RestrictScope(request.Scope))
+
+	err = s.sessions.CreateSession(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
-	return s.createTokenSet(cred.Username()), nil
+	return s.createTokenSet(session, policy, custom, now)
 }
 
 // RefreshToken refreshes a token
+// The refresh token is mandatory and decides the user and the session. The access token is optional,
+// but if given it must be a genuine, possibly expired, access token of the same session.
+// The presented refresh token is consumed and a new one of the same session is issued.
+// Presenting an already consumed refresh token revokes the whole session.
 // Returns:
 //   - ErrTokenInvalid if the token is invalid
+//   - ErrTokenMismatched if the access token belongs to another user or session
+//   - ErrTokenReused if the refresh token was already consumed
 //   - ErrUserNotFound if the user does not exist
 func (s *Service) RefreshToken(ctx context.Context, tokenSet *TokenSetInput) (*TokenSetOutput, error) {
-	username, err := s.extractSubject(tokenSet)
+	now := time.Now()
+
+	claims, err := s.extractRefreshClaims(ctx, tokenSet, now)
 	if err != nil {
-		return nil, casError(err, ErrTokenInvalid, ErrTokenInvalid)
+		return nil, err
 	}
 
-	cred, err := s.repo.GetCredential(ctx, username)
+	session, err := s.sessions.GetSession(ctx, claims.SessionID())
+	if err != nil {
+		return nil, casError(err, sessionrepository.ErrSessionNotFound, ErrTokenInvalid)
+	}
+
+	if session.Username() != claims.Subject() {
+		return nil, ErrTokenInvalid
+	}
+
+	_, err = s.repo.GetCredential(ctx, session.Username())
 	if err != nil {
 		return nil, casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
 	}
 
-	return s.createTokenSet(cred.Username()), nil
-}
+	custom, err := s.customClaims(ctx, session.Username())
+	if err != nil {
+		return nil, err
+	}
 
-func (s *Service) createTokenSet(username string) *TokenSetOutput {
-	policy := domain.NewTokenPolicy()
+	policy := s.tokenPolicy(session.ClientID(), custom)
 
-	accessToken := s.pubGen.GenerateToken(username, time.Now(), policy.AccessTokenDuration())
-	refreshToken := s.priGen.GenerateToken(username, time.Now(), policy.RefreshTokenDuration())
-	expiresIn := int(policy.AccessTokenDuration().Seconds())
+	expiresAt := policy.RefreshTokenExpiresAt(session.CreatedAt(), now)
+	if !expiresAt.After(now) {
+		// the session has reached its lifetime
+		return nil, ErrTokenInvalid
+	}
 
-	return &TokenSetOutput{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    expiresIn,
+	grant, err := s.grant(ctx, session.Username())
+	if err != nil {
+		return nil, err
+	}
+
+	// the grant of the user may have shrunk since the login
+	rotated := session.Rotate(newID(), expiresAt).
+		WithGrant(grant.RestrictAudience(session.Audience()), grant.RestrictScope(session.Scope()))
+
+	err = s.sessions.UpdateSession(ctx, rotated, claims.ID())
+	if err != nil {
+		if !errors.Is(err, sessionrepository.ErrSessionConflict) {
+			return nil, casError(err, sessionrepository.ErrSessionNotFound, ErrTokenInvalid)
+		}
+
+		return nil, s.revokeReusedSession(ctx, session)
 	}
+
+	return s.createTokenSet(rotated, policy, custom, now)
 }
 
-func (s *Service) extractSubject(tokenSet *TokenSetInput) (string, error) {
-	subject, err := s.pubGen.ParseToken(tokenSet.AccessToken, time.Now())
-	if err == nil {
-		return subject, nil
+// RevokeToken revokes an access or a refresh token for an authenticated client as described in RFC 7009.
+// Revoking a refresh token also revokes its session. The hint only decides which kind of token is tried first.
+// Invalid and expired tokens are ignored, since there is nothing left to revoke.
+// Returns:
+//   - ErrClientUnauthorized if the token was issued to another client
+func (s *Service) RevokeToken(ctx context.Context, clientID string, token string, hint TokenTypeHint) error {
+	claims, tokenType, err := s.parseAnyToken(ctx, token, hint, time.Now())
+	if err != nil {
+		if errors.Is(err, ErrTokenInvalid) {
+			return nil
+		}
+
+		return err
 	}
 
-	subject, err = s.priGen.ParseToken(tokenSet.RefreshToken, time.Now())
-	if err == nil {
-		return subject, nil
+	// tokens issued without a client may be revoked by any client
+	if claims.ClientID() != "" && claims.ClientID() != clientID {
+		return ErrClientUnauthorized
+	}
+
+	if tokenType == TokenTypeHintRefreshToken {
+		err := s.revokeSessionOf(ctx, claims)
+		if err != nil {
+			return err
+		}
 	}
 
-	return "", ErrTokenInvalid
+	return s.revokeToken(ctx, claims)
+}
+
+// CreateClient registers a client allowed to introspect tokens
+// Returns:
+//   - ErrClientAlreadyExists if the client already exists
+func (s *Service) CreateClient(ctx context.Context, client *Client) error {
+	hashedSecret, err := s.hasher.Hash(client.Secret)
+	if err != nil {
+		return fmt.Errorf("failed to hash secret: %w", err)
+	}
+
+	err = s.clients.CreateClient(ctx, domain.NewClient(client.ID, hashedSecret))
+	if err != nil {
+		return casError(err, clientrepository.ErrClientAlreadyExists, ErrClientAlreadyExists)
+	}
+
+	return nil
+}
+
+// AuthenticateClient checks the credentials of a client
+// Returns:
+//   - ErrClientUnauthorized if the client does not exist or the secret is wrong
+func (s *Service) AuthenticateClient(ctx context.Context, client *Client) error {
+	cred, err := s.clients.GetClient(ctx, client.ID)
+	if err != nil {
+		return casError(err, clientrepository.ErrClientNotFound, ErrClientUnauthorized)
+	}
+
+	err = s.hasher.Compare(client.Secret, cred.Secret())
+	if err != nil {
+		return casError(err, hasher.ErrMismatched, ErrClientUnauthorized)
+	}
+
+	return nil
+}
+
+// IntrospectToken describes an access or a refresh token as in RFC 7662.
+// The hint only decides which kind of token is tried first.
+// Invalid, expired and revoked tokens, tokens of disabled or deleted users, access tokens of revoked sessions
+// and consumed refresh tokens are reported inactive.
+func (s *Service) IntrospectToken(ctx context.Context, token string, hint TokenTypeHint) (*Introspection, error) {
+	now := time.Now()
+
+	claims, tokenType, err := s.parseAnyToken(ctx, token, hint, now)
+	if err != nil {
+		if errors.Is(err, ErrTokenInvalid) {
+			return &Introspection{Active: false}, nil //nolint:exhaustruct
+		}
+
+		return nil, err
+	}
+
+	if tokenType == TokenTypeHintRefreshToken {
+		session, err := s.sessions.GetSession(ctx, claims.SessionID())
+		if err != nil {
+			if errors.Is(err, sessionrepository.ErrSessionNotFound) {
+				return &Introspection{Active: false}, nil //nolint:exhaustruct
+			}
+
+			return nil, fmt.Errorf("failed to get session: %w", err)
+		}
+
+		if session.RefreshTokenID() != claims.ID() {
+			return &Introspection{Active: false}, nil //nolint:exhaustruct
+		}
+	}
+
+	typ := TokenTypeBearer
+	if tokenType == TokenTypeHintRefreshToken {
+		typ = ""
+	}
+
+	return &Introspection{
+		Active:    true,
+		TokenType: typ,
+		TokenID:   claims.ID(),
+		Subject:   claims.Subject(),
+		Issuer:    claims.Issuer(),
+		Audience:  claims.Audience(),
+		Scope:     strings.Join(claims.Scope(), " "),
+		ClientID:  claims.ClientID(),
+		IssuedAt:  claims.IssuedAt(),
+		ExpiresAt: claims.ExpiresAt(),
+		Claims:    claims.CustomClaims(),
+	}, nil
+}
+
+// ListPublicKeys lists the keys that verify access tokens.
+// Refresh tokens are only ever verified by this service, so their keys are not listed.
+func (s *Service) ListPublicKeys(ctx context.Context) []*PublicKey {
+	var ret []*PublicKey
+
+	for _, key := range s.pubGen.PublicKeys(time.Now()) {
+		ret = append(ret, &PublicKey{
+			KeyType:   key.KeyType,
+			Use:       key.Use,
+			Algorithm: key.Algorithm,
+			KeyID:     key.KeyID,
+			N:         key.N,
+			E:         key.E,
+			Curve:     key.Curve,
+			X:         key.X,
+			Y:         key.Y,
+		})
+	}
+
+	return ret
+}
+
+// ListUsers lists a page of users for an admin
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrForbidden if the token has neither the admin role nor the admin scope
+func (s *Service) ListUsers(ctx context.Context, query *UserQuery) (*UserPage, error) {
+	_, err := s.authorizeAdmin(ctx, query.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := max(query.Limit, 1)
+
+	// one more than the page tells whether there is a next page
+	creds, err := s.repo.ListCredentials(ctx, query.After, query.Search, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	page := &UserPage{Users: nil, Next: ""}
+
+	for _, cred := range creds {
+		if len(page.Users) == limit {
+			page.Next = page.Users[len(page.Users)-1].Username
+
+			break
+		}
+
+		user, err := s.repo.GetUser(ctx, cred.Username())
+		if err != nil {
+			if errors.Is(err, credentialrepository.ErrCredentialNotFound) {
+				// deleted since it was listed
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+
+		page.Users = append(page.Users, newUser(cred, user))
+	}
+
+	return page, nil
+}
+
+// InspectUser returns the profile and the state of any user for an admin
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrForbidden if the token has neither the admin role nor the admin scope
+//   - ErrUserNotFound if the user does not exist
+func (s *Service) InspectUser(ctx context.Context, token, username string) (*User, error) {
+	_, err := s.authorizeAdmin(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getUser(ctx, s.usernames.Normalize(username))
+}
+
+// ForceDeleteUser deletes any user for an admin
+// Every session of the user is revoked, like in DeleteUser.
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrForbidden if the token has neither the admin role nor the admin scope
+//   - ErrUserNotFound if the user does not exist
+func (s *Service) ForceDeleteUser(ctx context.Context, token, username string) error {
+	_, err := s.authorizeAdmin(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	cred, err := s.repo.GetCredential(ctx, s.usernames.Normalize(username))
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	err = s.repo.DeleteCredential(ctx, cred)
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	return s.revokeSessions(ctx, cred.Username())
+}
+
+// DisableUser refuses logins of the user until EnableUser
+// Every token issued to the user is rejected while it is disabled and every session is revoked.
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrForbidden if the token has neither the admin role nor the admin scope
+//   - ErrUserNotFound if the user does not exist
+func (s *Service) DisableUser(ctx context.Context, token, username string) error {
+	now := time.Now()
+
+	err := s.updateCredential(ctx, token, username, func(cred *domain.Credential) *domain.Credential {
+		// tokens issued before stay invalid once the user is enabled again. Unlike a password change it is
+		// not truncated, so tokens issued in the second of the change are rejected as well
+		return cred.WithDisabled(true).WithTokensValidAfter(now)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.revokeSessions(ctx, s.usernames.Normalize(username))
+}
+
+// EnableUser allows logins of a user disabled by DisableUser again
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrForbidden if the token has neither the admin role nor the admin scope
+//   - ErrUserNotFound if the user does not exist
+func (s *Service) EnableUser(ctx context.Context, token, username string) error {
+	return s.updateCredential(ctx, token, username, func(cred *domain.Credential) *domain.Credential {
+		return cred.WithDisabled(false)
+	})
+}
+
+// UnlockUser lifts the lockout of the user and forgets its failed logins
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrForbidden if the token has neither the admin role nor the admin scope
+//   - ErrUserNotFound if the user does not exist
+func (s *Service) UnlockUser(ctx context.Context, token, username string) error {
+	return s.updateCredential(ctx, token, username, func(cred *domain.Credential) *domain.Credential {
+		return cred.WithFailedLogins(0).WithLockedUntil(time.Time{})
+	})
+}
+
+// ForcePasswordReset replaces the password of the user with a random one and mails a reset token
+// to the user, e.g. when the password leaked. Like a password change, it rejects every token issued before it
+// and revokes every session.
+// Returns:
+//   - ErrNotImplemented if password reset is not configured
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrForbidden if the token has neither the admin role nor the admin scope
+//   - ErrUserNotFound if the user does not exist
+//   - ErrEmailInvalid if the user has no email to mail the reset token to, see SetUserEmail
+func (s *Service) ForcePasswordReset(ctx context.Context, token, username string) error {
+	if s.resets == nil {
+		return ErrNotImplemented
+	}
+
+	now := time.Now()
+
+	_, err := s.authorizeAdmin(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	cred, err := s.repo.GetCredential(ctx, s.usernames.Normalize(username))
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	// without an email the user could never log in again
+	if cred.Email() == "" {
+		return fmt.Errorf("%w: user %q has no email", ErrEmailInvalid, cred.Username())
+	}
+
+	hashedPassword, err := s.hasher.Hash(newID())
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	cred, err = s.modifyCredential(ctx, cred.Username(), func(stored *domain.Credential) (*domain.Credential, error) {
+		if stored.Email() == "" {
+			return nil, fmt.Errorf("%w: user %q has no email", ErrEmailInvalid, stored.Username())
+		}
+
+		return stored.WithPassword(hashedPassword).WithTokensValidAfter(now.Truncate(time.Second)), nil
+	})
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	s.touchUser(ctx, cred.Username())
+
+	err = s.revokeSessions(ctx, cred.Username())
+	if err != nil {
+		return err
+	}
+
+	return s.sendReset(ctx, cred, now)
+}
+
+// SetUserEmail sets the email of a user for an admin, e.g. of a user imported without one
+// Unless the admin vouches for it, the email is unverified and mailed a verification token, like in UpdateUser.
+// Returns:
+//   - ErrTokenInvalid if the token is invalid
+//   - ErrForbidden if the token has neither the admin role nor the admin scope
+//   - ErrUserNotFound if the user does not exist
+//   - ErrEmailInvalid if the email is no mail address, or empty while a verified email is required
+func (s *Service) SetUserEmail(ctx context.Context, change *EmailChange) error {
+	_, err := s.authorizeAdmin(ctx, change.Token)
+	if err != nil {
+		return err
+	}
+
+	email, err := s.validateEmail(change.Email)
+	if err != nil {
+		return err
+	}
+
+	err = s.changeEmail(ctx, s.usernames.Normalize(change.Username), email, change.Verified)
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// PurgeExpired deletes expired sessions and revocations, so the stores do not grow without bound.
+// It is meant to run periodically.
+func (s *Service) PurgeExpired(ctx context.Context) error {
+	now := time.Now()
+
+	deleted, err := s.sessions.DeleteExpiredSessions(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	if deleted > 0 {
+		log.Printf("deleted %d expired sessions", deleted)
+	}
+
+	deleted, err = s.revocations.DeleteExpiredRevocations(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired revocations: %w", err)
+	}
+
+	if deleted > 0 {
+		log.Printf("deleted %d expired revocations", deleted)
+	}
+
+	return nil
+}
+
+// validatePassword checks a password a user wants to set against the password policy and known breaches.
+func (s *Service) validatePassword(ctx context.Context, username, password string) error {
+	err := s.passwords.Validate(username, password)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrPasswordInvalid, err)
+	}
+
+	if s.breaches == nil {
+		return nil
+	}
+
+	breached, err := s.breaches.Breached(ctx, password)
+	if err != nil {
+		return fmt.Errorf("failed to check breached passwords: %w", err)
+	}
+
+	if breached {
+		return fmt.Errorf("%w: %w", ErrPasswordInvalid, ErrPasswordBreached)
+	}
+
+	return nil
+}
+
+// validateEmail normalizes an email a user or an admin wants to set.
+func (s *Service) validateEmail(email string) (string, error) {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return "", err
+	}
+
+	if email == "" && s.requireVerifiedEmail {
+		return "", fmt.Errorf("%w: an email is required to log in", ErrEmailInvalid)
+	}
+
+	return email, nil
+}
+
+// changeEmail sets the email of the user, verified or not. A new unverified email is mailed a verification
+// token, a failed mail is only logged like in CreateUser. Setting the email the user has already keeps its
+// verification.
+func (s *Service) changeEmail(ctx context.Context, username, email string, verified bool) error {
+	var changed bool
+
+	cred, err := s.modifyCredential(ctx, username, func(stored *domain.Credential) (*domain.Credential, error) {
+		changed = stored.Email() != email || (verified && !stored.EmailVerified())
+		if !changed {
+			return stored, nil
+		}
+
+		return stored.WithEmail(email).WithEmailVerified(verified), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		return nil
+	}
+
+	s.touchUser(ctx, username)
+
+	if s.verifications != nil && email != "" && !verified {
+		err = s.sendVerification(ctx, cred, time.Now())
+		if err != nil {
+			log.Printf("failed to send verification mail to %q: %v", username, err)
+		}
+	}
+
+	return nil
+}
+
+// failLogin counts a failed login of the user and locks the user out after too many.
+// Like rehash, a failure to store it does not change the outcome of the login. Concurrent failed logins
+// are all counted.
+func (s *Service) failLogin(ctx context.Context, cred *domain.Credential) {
+	if s.lockout.MaxFailedLogins() <= 0 {
+		return
+	}
+
+	_, err := s.modifyCredential(ctx, cred.Username(), func(stored *domain.Credential) (*domain.Credential, error) {
+		return s.lockout.FailedLogin(stored, time.Now()), nil
+	})
+	if err != nil {
+		log.Printf("failed to store failed login of %q: %v", cred.Username(), err)
+	}
+}
+
+// passLogin forgets the failed logins before a successful one.
+// Returns the credential as stored afterwards, or cred if that failed.
+func (s *Service) passLogin(ctx context.Context, cred *domain.Credential) *domain.Credential {
+	if cred.FailedLogins() == 0 {
+		return cred
+	}
+
+	stored, err := s.modifyCredential(ctx, cred.Username(), func(stored *domain.Credential) (*domain.Credential, error) {
+		return stored.WithFailedLogins(0), nil
+	})
+	if err != nil {
+		log.Printf("failed to reset failed logins of %q: %v", cred.Username(), err)
+
+		return cred
+	}
+
+	return stored
+}
+
+// authorizeAdmin parses an access token for key-stone that has the admin scope or the admin role.
+func (s *Service) authorizeAdmin(ctx context.Context, token string) (*domain.Claims, error) {
+	claims, err := s.authenticate(ctx, token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if s.adminScope != "" && claims.HasScope(s.adminScope) {
+		return claims, nil
+	}
+
+	if s.adminRole != "" && slices.Contains(claims.Strings("roles"), s.adminRole) {
+		return claims, nil
+	}
+
+	return nil, ErrForbidden
+}
+
+// updateCredential applies change to the credential of the user for an admin.
+func (s *Service) updateCredential(
+	ctx context.Context,
+	token string,
+	username string,
+	change func(cred *domain.Credential) *domain.Credential,
+) error {
+	_, err := s.authorizeAdmin(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.modifyCredential(ctx, s.usernames.Normalize(username),
+		func(stored *domain.Credential) (*domain.Credential, error) {
+			return change(stored), nil
+		},
+	)
+	if err != nil {
+		return casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// modifyCredential reads the credential of the user, applies change and stores the result, unless someone
+// else changed the credential in between. Then change is applied to the credential as changed, so concurrent
+// modifications never overwrite each other. An error of change is returned as is and stores nothing,
+// like returning the credential unchanged does.
+// Returns the stored credential.
+func (s *Service) modifyCredential(
+	ctx context.Context,
+	username string,
+	change func(stored *domain.Credential) (*domain.Credential, error),
+) (*domain.Credential, error) {
+	const attempts = 10
+
+	for range attempts {
+		stored, err := s.repo.GetCredential(ctx, username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get credential: %w", err)
+		}
+
+		updated, err := change(stored)
+		if err != nil {
+			return nil, err
+		}
+
+		if updated == stored {
+			return stored, nil
+		}
+
+		err = s.repo.CompareAndUpdateCredential(ctx, stored, updated)
+		if err == nil {
+			return updated, nil
+		}
+
+		if !errors.Is(err, credentialrepository.ErrCredentialChanged) {
+			return nil, fmt.Errorf("failed to update credential: %w", err)
+		}
+	}
+
+	return nil, fmt.Errorf("failed to update credential: %w", credentialrepository.ErrCredentialChanged)
+}
+
+// touchUser marks the profile of the user as updated after the user or an admin changed the password
+// or the email.
+// Bookkeeping of logins, like failed logins or rehash, does not. The change is stored already,
+// so a failure is only logged.
+func (s *Service) touchUser(ctx context.Context, username string) {
+	err := s.repo.TouchUser(ctx, username)
+	if err != nil {
+		log.Printf("failed to mark %q as updated: %v", username, err)
+	}
+}
+
+// rehash upgrades the stored hash of a password that was just verified, e.g. from bcrypt to argon2id.
+// The login does not depend on it, a failed upgrade is retried on the next login.
+// A hash changed in the meantime, e.g. by a password change, is left alone.
+func (s *Service) rehash(ctx context.Context, cred *domain.Credential, password string) {
+	if !s.hasher.NeedsRehash(cred.Password()) {
+		return
+	}
+
+	hashedPassword, err := s.hasher.Hash(password)
+	if err != nil {
+		log.Printf("failed to rehash password of %q: %v", cred.Username(), err)
+
+		return
+	}
+
+	_, err = s.modifyCredential(ctx, cred.Username(), func(stored *domain.Credential) (*domain.Credential, error) {
+		if stored.Password() != cred.Password() {
+			return stored, nil
+		}
+
+		return stored.WithPassword(hashedPassword), nil
+	})
+	if err != nil {
+		log.Printf("failed to store rehashed password of %q: %v", cred.Username(), err)
+	}
+}
+
+// revokeReusedSession revokes a session whose refresh token was presented twice.
+// Both the thief and the legitimate user lose the session and have to log in again.
+func (s *Service) revokeReusedSession(ctx context.Context, session *domain.Session) error {
+	err := s.sessions.DeleteSession(ctx, session)
+	if err != nil && !errors.Is(err, sessionrepository.ErrSessionNotFound) {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return ErrTokenReused
+}
+
+func (s *Service) revokeSessionOf(ctx context.Context, claims *domain.Claims) error {
+	session, err := s.sessions.GetSession(ctx, claims.SessionID())
+	if err != nil {
+		if errors.Is(err, sessionrepository.ErrSessionNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	err = s.sessions.DeleteSession(ctx, session)
+	if err != nil && !errors.Is(err, sessionrepository.ErrSessionNotFound) {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) revokeSessions(ctx context.Context, username string) error {
+	sessions, err := s.sessions.ListSessions(ctx, username)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	for _, session := range sessions {
+		err := s.sessions.DeleteSession(ctx, session)
+		if err != nil && !errors.Is(err, sessionrepository.ErrSessionNotFound) {
+			return fmt.Errorf("failed to revoke session: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// revokeToken denies the token until it expires.
+func (s *Service) revokeToken(ctx context.Context, claims *domain.Claims) error {
+	err := s.revocations.CreateRevocation(ctx, domain.NewRevocation(claims.ID(), claims.ExpiresAt()))
+	if err != nil && !errors.Is(err, revocationrepository.ErrRevocationAlreadyExists) {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	return nil
+}
+
+// parseAnyToken parses an access or a refresh token, trying the kind named by the hint first.
+func (s *Service) parseAnyToken(
+	ctx context.Context,
+	token string,
+	hint TokenTypeHint,
+	now time.Time,
+) (*domain.Claims, TokenTypeHint, error) {
+	tokenTypes := []TokenTypeHint{TokenTypeHintAccessToken, TokenTypeHintRefreshToken}
+	if hint == TokenTypeHintRefreshToken {
+		tokenTypes = []TokenTypeHint{TokenTypeHintRefreshToken, TokenTypeHintAccessToken}
+	}
+
+	for _, tokenType := range tokenTypes {
+		parse := s.parseAccessToken
+		if tokenType == TokenTypeHintRefreshToken {
+			parse = s.parseRefreshToken
+		}
+
+		claims, err := parse(ctx, token, now)
+		if err == nil {
+			return claims, tokenType, nil
+		}
+
+		if !errors.Is(err, ErrTokenInvalid) {
+			return nil, "", err
+		}
+	}
+
+	return nil, "", ErrTokenInvalid
+}
+
+// authenticate parses an access token of a user calling key-stone itself, which must be issued for its audience.
+// Tokens for other services are rejected, so they cannot be replayed against the endpoints of users and admins.
+func (s *Service) authenticate(ctx context.Context, token string, now time.Time) (*domain.Claims, error) {
+	claims, err := s.pubGen.VerifyToken(token, now, s.audience)
+	if err != nil {
+		return nil, casError(err, tokengenerator.ErrTokenInvalid, ErrTokenInvalid)
+	}
+
+	return claims, s.checkAccessToken(ctx, claims, now)
+}
+
+// parseAccessToken parses an access token of any audience that is not revoked and whose session still exists,
+// so revoking a session, e.g. with its refresh token or on reuse, also revokes its access tokens.
+func (s *Service) parseAccessToken(ctx context.Context, token string, now time.Time) (*domain.Claims, error) {
+	claims, err := s.pubGen.ParseToken(token, now)
+	if err != nil {
+		return nil, casError(err, tokengenerator.ErrTokenInvalid, ErrTokenInvalid)
+	}
+
+	return claims, s.checkAccessToken(ctx, claims, now)
+}
+
+// checkAccessToken returns ErrTokenInvalid if an access token is revoked or its session is gone.
+func (s *Service) checkAccessToken(ctx context.Context, claims *domain.Claims, now time.Time) error {
+	err := s.checkRevocation(ctx, claims, now)
+	if err != nil {
+		return err
+	}
+
+	return s.checkSession(ctx, claims)
+}
+
+func (s *Service) parseRefreshToken(ctx context.Context, token string, now time.Time) (*domain.Claims, error) {
+	claims, err := s.priGen.ParseToken(token, now)
+	if err != nil {
+		return nil, casError(err, tokengenerator.ErrTokenInvalid, ErrTokenInvalid)
+	}
+
+	return claims, s.checkRevocation(ctx, claims, now)
+}
+
+// checkSession returns ErrTokenInvalid if the session of an access token is gone.
+func (s *Service) checkSession(ctx context.Context, claims *domain.Claims) error {
+	if claims.SessionID() == "" {
+		return ErrTokenInvalid
+	}
+
+	session, err := s.sessions.GetSession(ctx, claims.SessionID())
+	if err != nil {
+		if errors.Is(err, sessionrepository.ErrSessionNotFound) {
+			return ErrTokenInvalid
+		}
+
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	if session.Username() != claims.Subject() {
+		return ErrTokenInvalid
+	}
+
+	return nil
+}
+
+// checkRevocation returns ErrTokenInvalid if the token was revoked, on its own or by a password change.
+func (s *Service) checkRevocation(ctx context.Context, claims *domain.Claims, now time.Time) error {
+	if claims.ID() == "" {
+		return ErrTokenInvalid
+	}
+
+	_, err := s.revocations.GetRevocation(ctx, claims.ID(), now)
+	if err == nil {
+		return ErrTokenInvalid
+	}
+
+	if !errors.Is(err, revocationrepository.ErrRevocationNotFound) {
+		return fmt.Errorf("failed to get revocation: %w", err)
+	}
+
+	return s.checkTokensValidAfter(ctx, claims)
+}
+
+// checkTokensValidAfter returns ErrTokenInvalid if the token was issued before the tokens of its user
+// were invalidated, e.g. by a password change, or its user is disabled or deleted.
+func (s *Service) checkTokensValidAfter(ctx context.Context, claims *domain.Claims) error {
+	cred, err := s.repo.GetCredential(ctx, claims.Subject())
+	if err != nil {
+		if errors.Is(err, credentialrepository.ErrCredentialNotFound) {
+			return ErrTokenInvalid
+		}
+
+		return fmt.Errorf("failed to get credential: %w", err)
+	}
+
+	if cred.Disabled() || claims.IssuedAt().Before(cred.TokensValidAfter()) {
+		return ErrTokenInvalid
+	}
+
+	return nil
+}
+
+func (s *Service) createTokenSet(
+	session *domain.Session,
+	policy *domain.TokenPolicy,
+	custom map[string]any,
+	now time.Time,
+) (*TokenSetOutput, error) {
+	expiresAt := policy.AccessTokenExpiresAt(session.CreatedAt(), now)
+
+	accessClaims, err := domain.NewClaims(newID(), session.Username(), now, expiresAt).
+		WithAudience(session.Audience()...).
+		WithScope(session.Scope()...).
+		WithSessionID(session.ID()).
+		WithClientID(session.ClientID()).
+		WithCustomClaims(custom)
+	if err != nil {
+		return nil, fmt.Errorf("invalid custom claims: %w", err)
+	}
+
+	refreshClaims := domain.NewClaims(session.RefreshTokenID(), session.Username(), now, session.ExpiresAt()).
+		WithSessionID(session.ID()).
+		WithClientID(session.ClientID())
+
+	accessToken, err := s.pubGen.GenerateToken(accessClaims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	refreshToken, err := s.priGen.GenerateToken(refreshClaims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	expiresIn := int(expiresAt.Sub(now).Seconds())
+
+	return &TokenSetOutput{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    expiresIn,
+		Scope:        session.Scope(),
+	}, nil
+}
+
+// grant returns what the user may request tokens for. The audience of key-stone itself is always granted.
+func (s *Service) grant(ctx context.Context, username string) (*domain.Grant, error) {
+	if s.grants == nil {
+		return domain.NewGrant([]string{s.audience}, nil), nil
+	}
+
+	grant, err := s.grants.GetGrant(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grant: %w", err)
+	}
+
+	return domain.NewGrant(append(grant.Audience(), s.audience), grant.Scope()), nil
+}
+
+// customClaims merges the profile claims and the claims of the claims provider.
+// User editable attributes stay nested in "attributes", so users cannot give themselves roles.
+func (s *Service) customClaims(ctx context.Context, username string) (map[string]any, error) {
+	var custom map[string]any
+
+	if s.profileClaims {
+		user, err := s.repo.GetUser(ctx, username)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+
+		custom = profileClaims(user)
+	}
+
+	if s.claims == nil {
+		return custom, nil
+	}
+
+	provided, err := s.claims.GetClaims(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom claims: %w", err)
+	}
+
+	if custom == nil {
+		return provided, nil
+	}
+
+	maps.Copy(custom, provided)
+
+	return custom, nil
+}
+
+// tokenPolicy decides the token policy of a session.
+// The policy of the first role of the user that has one wins, then the policy of the client, then the default.
+func (s *Service) tokenPolicy(clientID string, custom map[string]any) *domain.TokenPolicy {
+	// invalid custom claims are reported when the token is created
+	roles, err := domain.NewClaims("", "", time.Time{}, time.Time{}).WithCustomClaims(custom)
+	if err == nil {
+		for _, role := range roles.Strings("roles") {
+			if policy, ok := s.rolePolicies[role]; ok {
+				return policy
+			}
+		}
+	}
+
+	if policy, ok := s.clientPolicies[clientID]; ok && clientID != "" {
+		return policy
+	}
+
+	return s.policy
+}
+
+func (s *Service) extractRefreshClaims(
+	ctx context.Context,
+	tokenSet *TokenSetInput,
+	now time.Time,
+) (*domain.Claims, error) {
+	claims, err := s.parseRefreshToken(ctx, tokenSet.RefreshToken, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenSet.AccessToken == "" {
+		return claims, nil
+	}
+
+	// the access token has usually expired by the time it is refreshed
+	access, err := s.pubGen.InspectToken(tokenSet.AccessToken, now)
+	if err != nil {
+		return nil, casError(err, tokengenerator.ErrTokenInvalid, ErrTokenInvalid)
+	}
+
+	err = s.checkRevocation(ctx, access, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if access.Subject() != claims.Subject() || access.SessionID() != claims.SessionID() {
+		return nil, ErrTokenMismatched
+	}
+
+	return claims, nil
+}
+
+func (s *Service) resetMailBody(cred *domain.Credential, secret string) string {
+	instruction := "Enter this reset token to choose a new password:\n" + secret
+	if s.resetLink != "" {
+		instruction = "Open this link to choose a new password:\n" +
+			strings.ReplaceAll(s.resetLink, "{token}", url.QueryEscape(secret))
+	}
+
+	return fmt.Sprintf("Someone asked to reset the password of %s.\n\n%s\n\n"+
+		"It works once and expires in %s. If you did not ask for it, ignore this mail, "+
+		"your password stays the same.\n", cred.Username(), instruction, s.resetLifetime)
+}
+
+func (s *Service) getUser(ctx context.Context, username string) (*User, error) {
+	cred, err := s.repo.GetCredential(ctx, username)
+	if err != nil {
+		return nil, casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	user, err := s.repo.GetUser(ctx, username)
+	if err != nil {
+		return nil, casError(err, credentialrepository.ErrCredentialNotFound, ErrUserNotFound)
+	}
+
+	return newUser(cred, user), nil
+}
+
+func (s *Service) sendReset(ctx context.Context, cred *domain.Credential, now time.Time) error {
+	secret := newID()
+
+	err := s.resets.CreateResetToken(ctx,
+		domain.NewResetToken(secretID(secret), cred.Username(), now, now.Add(s.resetLifetime)))
+	if err != nil {
+		return fmt.Errorf("failed to create reset token: %w", err)
+	}
+
+	err = s.mailer.Send(ctx, domain.NewMail(cred.Email(), "Reset your password", s.resetMailBody(cred, secret)))
+	if err != nil {
+		return fmt.Errorf("failed to send reset mail: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) sendVerification(ctx context.Context, cred *domain.Credential, now time.Time) error {
+	secret := newID()
+
+	err := s.verifications.CreateVerificationToken(ctx, domain.NewVerificationToken(
+		secretID(secret), cred.Username(), cred.Email(), now, now.Add(s.verificationLifetime)))
+	if err != nil {
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+
+	err = s.mailer.Send(ctx, domain.NewMail(cred.Email(), "Verify your email", s.verificationMailBody(cred, secret)))
+	if err != nil {
+		return fmt.Errorf("failed to send verification mail: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) verificationMailBody(cred *domain.Credential, secret string) string {
+	instruction := "Enter this verification token to verify it:\n" + secret
+	if s.verificationLink != "" {
+		instruction = "Open this link to verify it:\n" +
+			strings.ReplaceAll(s.verificationLink, "{token}", url.QueryEscape(secret))
+	}
+
+	return fmt.Sprintf("This email was given for the user %s.\n\n%s\n\n"+
+		"It works once and expires in %s. If you did not sign up, ignore this mail.\n",
+		cred.Username(), instruction, s.verificationLifetime)
+}
+
+func newUser(cred *domain.Credential, user *domain.User) *User {
+	// a lockout that ended is left out
+	var lockedUntil time.Time
+	if cred.IsLocked(time.Now()) {
+		lockedUntil = cred.LockedUntil()
+	}
+
+	return &User{
+		Username:      user.Username(),
+		Email:         cred.Email(),
+		EmailVerified: cred.EmailVerified(),
+		Disabled:      cred.Disabled(),
+		LockedUntil:   lockedUntil,
+		DisplayName:   user.DisplayName(),
+		Locale:        user.Locale(),
+		Attributes:    user.Attributes(),
+		CreatedAt:     user.CreatedAt(),
+		UpdatedAt:     user.UpdatedAt(),
+	}
+}
+
+// secretID hashes a secret mailed to the user into the ID of its token, so only the user knows the secret.
+func secretID(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func normalizeEmail(email string) (string, error) {
+	if email == "" {
+		return "", nil
+	}
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Name != "" {
+		return "", fmt.Errorf("email %q: %w", email, ErrEmailInvalid)
+	}
+
+	return address.Address, nil
+}
+
+func normalizeProfile(user *domain.User) (*domain.User, error) {
+	const (
+		maxDisplayName    = 128
+		maxAttributes     = 32
+		maxAttributeKey   = 64
+		maxAttributeValue = 1024
+	)
+
+	if utf8.RuneCountInString(user.DisplayName()) > maxDisplayName {
+		return nil, fmt.Errorf("%w: display name must be at most %d characters", ErrProfileInvalid, maxDisplayName)
+	}
+
+	if user.Locale() != "" {
+		tag, err := language.Parse(user.Locale())
+		if err != nil {
+			return nil, fmt.Errorf("%w: locale %q: %w", ErrProfileInvalid, user.Locale(), err)
+		}
+
+		user = user.WithLocale(tag.String())
+	}
+
+	attributes := user.Attributes()
+	if len(attributes) > maxAttributes {
+		return nil, fmt.Errorf("%w: at most %d attributes are allowed", ErrProfileInvalid, maxAttributes)
+	}
+
+	for key, value := range attributes {
+		keyLength := utf8.RuneCountInString(key)
+		if keyLength == 0 || keyLength > maxAttributeKey || utf8.RuneCountInString(value) > maxAttributeValue {
+			return nil, fmt.Errorf("%w: attribute %q must have a key of 1 to %d and a value of at most %d characters",
+				ErrProfileInvalid, key, maxAttributeKey, maxAttributeValue)
+		}
+	}
+
+	return user, nil
+}
+
+// profileClaims turns the profile into claims, leaving out empty fields.
+func profileClaims(user *domain.User) map[string]any {
+	custom := make(map[string]any)
+
+	if user.DisplayName() != "" {
+		custom["name"] = user.DisplayName()
+	}
+
+	if user.Locale() != "" {
+		custom["locale"] = user.Locale()
+	}
+
+	if attributes := user.Attributes(); len(attributes) > 0 {
+		custom["attributes"] = attributes
+	}
+
+	return custom
+}
+
+func newID() string {
+	return rand.Text()
 }
 
 func casError(err error, expected error, fresh error) error {
@@ -0,0 +1,1428 @@
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/app/flow"
+	clientmemory "github.com/neatflowcv/key-stone/internal/pkg/clientrepository/memory"
+	credentialmemory "github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/memory"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/argon2id"
+	resettokenmemory "github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/memory"
+	revocationmemory "github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/memory"
+	sessionmemory "github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/memory"
+	vaultgenerator "github.com/neatflowcv/key-stone/internal/pkg/tokengenerator/vault"
+	verificationmemory "github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository/memory"
+	"github.com/neatflowcv/key-stone/pkg/vault"
+)
+
+const password = "Correct-Horse-9!"
+
+var errMailerDown = errors.New("mailer down")
+
+// brokenMailer fails every mail.
+type brokenMailer struct{}
+
+func (brokenMailer) Send(context.Context, *domain.Mail) error {
+	return errMailerDown
+}
+
+// claimsMap supplies the custom claims of users by username.
+type claimsMap map[string]map[string]any
+
+func (c claimsMap) GetClaims(_ context.Context, username string) (map[string]any, error) {
+	return c[username], nil
+}
+
+// grantsMap supplies the grants of users by username, users without one are granted nothing.
+type grantsMap map[string]*domain.Grant
+
+func (g grantsMap) GetGrant(_ context.Context, username string) (*domain.Grant, error) {
+	grant, ok := g[username]
+	if !ok {
+		return domain.NewGrant(nil, nil), nil
+	}
+
+	return grant, nil
+}
+
+// mailbox keeps every mail it is given, by recipient.
+type mailbox struct {
+	mu    sync.Mutex
+	mails map[string][]*domain.Mail
+}
+
+func newMailbox() *mailbox {
+	return &mailbox{mu: sync.Mutex{}, mails: make(map[string][]*domain.Mail)}
+}
+
+func (m *mailbox) Send(_ context.Context, mail *domain.Mail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mails[mail.To()] = append(m.mails[mail.To()], mail)
+
+	return nil
+}
+
+func (m *mailbox) count(to string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.mails[to])
+}
+
+// secret returns the token of the last mail to the address, mailed with the link "token:{token}".
+func (m *mailbox) secret(t *testing.T, to string) string {
+	t.Helper()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mails := m.mails[to]
+	if len(mails) == 0 {
+		t.Fatalf("no mail to %s", to)
+	}
+
+	_, rest, ok := strings.Cut(mails[len(mails)-1].Body(), "token:")
+	if !ok {
+		t.Fatalf("no token in the mail to %s", to)
+	}
+
+	secret, _, _ := strings.Cut(rest, "\n")
+
+	return secret
+}
+
+type fixture struct {
+	service *flow.Service
+	priGen  *vaultgenerator.Generator
+}
+
+func newFixture(t *testing.T, opts ...flow.Option) *fixture {
+	t.Helper()
+
+	// access and refresh tokens are signed with different keys, like --public-key and --private-key
+	pubKeys, err := vault.NewKeyRing(vault.NewHMACKey("access", []byte("access")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	priKeys, err := vault.NewKeyRing(vault.NewHMACKey("refresh", []byte("refresh")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	priGen := vaultgenerator.NewGenerator("key-stone", priKeys)
+	service := flow.NewService(
+		credentialmemory.NewRepository(),
+		clientmemory.NewRepository(),
+		sessionmemory.NewRepository(),
+		revocationmemory.NewRepository(),
+		newHasher(),
+		vaultgenerator.NewGenerator("key-stone", pubKeys),
+		priGen,
+		opts...,
+	)
+
+	return &fixture{service: service, priGen: priGen}
+}
+
+// newHasher returns a hasher that is quick rather than safe.
+func newHasher() *argon2id.Hasher {
+	params := argon2id.DefaultParams()
+	params.Memory = 64
+	params.Iterations = 1
+
+	return argon2id.NewHasher(params)
+}
+
+// login creates the user if needed and opens a new session.
+func (f *fixture) login(t *testing.T, username string) *flow.TokenSetOutput {
+	t.Helper()
+
+	credential := &flow.Credential{Username: username, Password: password, Email: ""}
+
+	err := f.service.CreateUser(t.Context(), credential)
+	if err != nil && !errors.Is(err, flow.ErrUserAlreadyExists) {
+		t.Fatal(err)
+	}
+
+	tokenSet, err := f.service.CreateToken(t.Context(), credential, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tokenSet
+}
+
+func TestRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input func(t *testing.T, f *fixture) *flow.TokenSetInput
+		want  error
+	}{
+		{
+			name: "refresh token alone",
+			input: func(t *testing.T, f *fixture) *flow.TokenSetInput {
+				t.Helper()
+
+				alice := f.login(t, "alice")
+
+				return &flow.TokenSetInput{AccessToken: "", RefreshToken: alice.RefreshToken}
+			},
+			want: nil,
+		},
+		{
+			name: "access token of the same session",
+			input: func(t *testing.T, f *fixture) *flow.TokenSetInput {
+				t.Helper()
+
+				alice := f.login(t, "alice")
+
+				return &flow.TokenSetInput{AccessToken: alice.AccessToken, RefreshToken: alice.RefreshToken}
+			},
+			want: nil,
+		},
+		{
+			name: "access token alone",
+			input: func(t *testing.T, f *fixture) *flow.TokenSetInput {
+				t.Helper()
+
+				alice := f.login(t, "alice")
+
+				return &flow.TokenSetInput{AccessToken: alice.AccessToken, RefreshToken: ""}
+			},
+			want: flow.ErrTokenInvalid,
+		},
+		{
+			name: "access token as refresh token",
+			input: func(t *testing.T, f *fixture) *flow.TokenSetInput {
+				t.Helper()
+
+				alice := f.login(t, "alice")
+
+				return &flow.TokenSetInput{AccessToken: "", RefreshToken: alice.AccessToken}
+			},
+			want: flow.ErrTokenInvalid,
+		},
+		{
+			name: "refresh token as access token",
+			input: func(t *testing.T, f *fixture) *flow.TokenSetInput {
+				t.Helper()
+
+				alice := f.login(t, "alice")
+
+				return &flow.TokenSetInput{AccessToken: alice.RefreshToken, RefreshToken: alice.RefreshToken}
+			},
+			want: flow.ErrTokenInvalid,
+		},
+		{
+			name: "access token of another user",
+			input: func(t *testing.T, f *fixture) *flow.TokenSetInput {
+				t.Helper()
+
+				alice := f.login(t, "alice")
+				bob := f.login(t, "bob")
+
+				return &flow.TokenSetInput{AccessToken: bob.AccessToken, RefreshToken: alice.RefreshToken}
+			},
+			want: flow.ErrTokenMismatched,
+		},
+		{
+			name: "access token of another session",
+			input: func(t *testing.T, f *fixture) *flow.TokenSetInput {
+				t.Helper()
+
+				first := f.login(t, "alice")
+				second := f.login(t, "alice")
+
+				return &flow.TokenSetInput{AccessToken: second.AccessToken, RefreshToken: first.RefreshToken}
+			},
+			want: flow.ErrTokenMismatched,
+		},
+		{
+			name: "refresh token bound to the session of another user",
+			input: func(t *testing.T, f *fixture) *flow.TokenSetInput {
+				t.Helper()
+
+				alice := f.login(t, "alice")
+				f.login(t, "bob")
+
+				claims, err := f.priGen.ParseToken(alice.RefreshToken, time.Now())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				// a genuine refresh token of bob that names the session of alice
+				forged, err := f.priGen.GenerateToken(
+					domain.NewClaims(claims.ID(), "bob", claims.IssuedAt(), claims.ExpiresAt()).
+						WithSessionID(claims.SessionID()),
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				return &flow.TokenSetInput{AccessToken: "", RefreshToken: forged}
+			},
+			want: flow.ErrTokenInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := newFixture(t)
+
+			_, err := f.service.RefreshToken(t.Context(), tt.input(t, f))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplayedRefreshTokenRevokesTheSession(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t)
+	stolen := f.login(t, "alice")
+	other := f.login(t, "alice")
+
+	refreshed, err := f.service.RefreshToken(t.Context(),
+		&flow.TokenSetInput{AccessToken: "", RefreshToken: stolen.RefreshToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.RefreshToken(t.Context(),
+		&flow.TokenSetInput{AccessToken: "", RefreshToken: stolen.RefreshToken})
+	if !errors.Is(err, flow.ErrTokenReused) {
+		t.Fatalf("got %v for the replayed refresh token, want %v", err, flow.ErrTokenReused)
+	}
+
+	// neither the thief nor the user can go on with the session, whoever refreshed first
+	_, err = f.service.RefreshToken(t.Context(),
+		&flow.TokenSetInput{AccessToken: "", RefreshToken: refreshed.RefreshToken})
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v for the latest refresh token, want %v", err, flow.ErrTokenInvalid)
+	}
+
+	for _, accessToken := range []string{stolen.AccessToken, refreshed.AccessToken} {
+		_, err = f.service.GetUser(t.Context(), accessToken)
+		if !errors.Is(err, flow.ErrTokenInvalid) {
+			t.Fatalf("got %v for an access token of the session, want %v", err, flow.ErrTokenInvalid)
+		}
+	}
+
+	// other sessions of the user are left alone
+	_, err = f.service.RefreshToken(t.Context(),
+		&flow.TokenSetInput{AccessToken: other.AccessToken, RefreshToken: other.RefreshToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRevokedTokensAreRejected(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t)
+
+	app := &flow.Client{ID: "app", Secret: "app-secret"}
+
+	err := f.service.CreateClient(t.Context(), app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	access := f.login(t, "alice")
+
+	err = f.service.RevokeToken(t.Context(), app.ID, access.AccessToken, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.GetUser(t.Context(), access.AccessToken)
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v for the revoked access token, want %v", err, flow.ErrTokenInvalid)
+	}
+
+	refresh := f.login(t, "alice")
+
+	err = f.service.RevokeToken(t.Context(), app.ID, refresh.RefreshToken, flow.TokenTypeHintRefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.RefreshToken(t.Context(),
+		&flow.TokenSetInput{AccessToken: "", RefreshToken: refresh.RefreshToken})
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v for the revoked refresh token, want %v", err, flow.ErrTokenInvalid)
+	}
+
+	// revoking the refresh token ends the session, and with it its access tokens
+	_, err = f.service.GetUser(t.Context(), refresh.AccessToken)
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v for the access token of the revoked session, want %v", err, flow.ErrTokenInvalid)
+	}
+
+	for _, token := range []string{access.AccessToken, refresh.AccessToken, refresh.RefreshToken} {
+		introspection, err := f.service.IntrospectToken(t.Context(), token, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if introspection.Active {
+			t.Fatalf("revoked token is active: %+v", introspection)
+		}
+	}
+}
+
+func TestRevokeRequiresTheClient(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t)
+
+	app := &flow.Client{ID: "app", Secret: "app-secret"}
+	other := &flow.Client{ID: "other", Secret: "other-secret"}
+
+	for _, client := range []*flow.Client{app, other} {
+		err := f.service.CreateClient(t.Context(), client)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the revoke endpoint authenticates the client before it revokes anything
+	for _, client := range []*flow.Client{
+		{ID: "app", Secret: "wrong"},
+		{ID: "unknown", Secret: "app-secret"},
+		{ID: "", Secret: ""},
+	} {
+		err := f.service.AuthenticateClient(t.Context(), client)
+		if !errors.Is(err, flow.ErrClientUnauthorized) {
+			t.Fatalf("got %v for client %q, want %v", err, client.ID, flow.ErrClientUnauthorized)
+		}
+	}
+
+	credential := &flow.Credential{Username: "alice", Password: password, Email: ""}
+
+	err := f.service.CreateUser(t.Context(), credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alice, err := f.service.CreateToken(t.Context(), credential, app, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = f.service.RevokeToken(t.Context(), other.ID, alice.RefreshToken, flow.TokenTypeHintRefreshToken)
+	if !errors.Is(err, flow.ErrClientUnauthorized) {
+		t.Fatalf("got %v for a token of another client, want %v", err, flow.ErrClientUnauthorized)
+	}
+
+	_, err = f.service.RefreshToken(t.Context(),
+		&flow.TokenSetInput{AccessToken: "", RefreshToken: alice.RefreshToken})
+	if err != nil {
+		t.Fatalf("got %v after another client tried to revoke the token, want it to work", err)
+	}
+}
+
+func TestAccessTokenRejectsRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t)
+	alice := f.login(t, "alice")
+
+	_, err := f.service.GetUser(t.Context(), alice.RefreshToken)
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v, want %v", err, flow.ErrTokenInvalid)
+	}
+
+	introspection, err := f.service.IntrospectToken(t.Context(), alice.RefreshToken,
+		flow.TokenTypeHintAccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the hint only decides the order, so the refresh token is found as what it is, which has no token type
+	if !introspection.Active || introspection.TokenType != "" {
+		t.Fatalf("got active %t and token type %q, want an active token without a type",
+			introspection.Active, introspection.TokenType)
+	}
+}
+
+func TestIntrospectToken(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t,
+		flow.WithClaimsProvider(claimsMap{"alice": {"team": "blue"}}),
+		flow.WithGrantProvider(grantsMap{"alice": domain.NewGrant([]string{"billing"}, []string{"read", "write"})}),
+	)
+
+	credential := &flow.Credential{Username: "alice", Password: password, Email: ""}
+
+	err := f.service.CreateUser(t.Context(), credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alice, err := f.service.CreateToken(t.Context(), credential, nil,
+		&flow.TokenRequest{Audience: []string{"billing"}, Scope: []string{"read"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	introspection, err := f.service.IntrospectToken(t.Context(), alice.AccessToken, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !introspection.Active || introspection.TokenType != flow.TokenTypeBearer {
+		t.Fatalf("got active %t and token type %q, want an active %s token",
+			introspection.Active, introspection.TokenType, flow.TokenTypeBearer)
+	}
+
+	if introspection.Subject != "alice" || introspection.Issuer != "key-stone" || introspection.TokenID == "" {
+		t.Fatalf("got subject %q, issuer %q and ID %q", introspection.Subject, introspection.Issuer,
+			introspection.TokenID)
+	}
+
+	if !slices.Equal(introspection.Audience, []string{"billing"}) || introspection.Scope != "read" {
+		t.Fatalf("got audience %v and scope %q, want [billing] and read", introspection.Audience, introspection.Scope)
+	}
+
+	if introspection.Claims["team"] != "blue" {
+		t.Fatalf("got claims %v, want the team claim", introspection.Claims)
+	}
+
+	if !introspection.ExpiresAt.After(introspection.IssuedAt) {
+		t.Fatalf("expires at %v, issued at %v", introspection.ExpiresAt, introspection.IssuedAt)
+	}
+
+	introspection, err = f.service.IntrospectToken(t.Context(), "not a token", flow.TokenTypeHintAccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if introspection.Active || introspection.Subject != "" {
+		t.Fatalf("got %+v for garbage, want an inactive token without information", introspection)
+	}
+}
+
+func TestConcurrentFailedLoginsLockTheUser(t *testing.T) {
+	t.Parallel()
+
+	const maxFailedLogins = 5
+
+	f := newFixture(t, flow.WithLockoutPolicy(domain.NewLockoutPolicy().WithMaxFailedLogins(maxFailedLogins)))
+	f.login(t, "alice")
+
+	var wg sync.WaitGroup
+
+	for range maxFailedLogins {
+		wg.Go(func() {
+			_, err := f.service.CreateToken(t.Context(),
+				&flow.Credential{Username: "alice", Password: "wrong", Email: ""}, nil, nil)
+			if !errors.Is(err, flow.ErrUserUnauthorized) {
+				t.Errorf("got %v, want %v", err, flow.ErrUserUnauthorized)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	// every wrong guess counts, however they interleave
+	_, err := f.service.CreateToken(t.Context(), &flow.Credential{Username: "alice", Password: password, Email: ""},
+		nil, nil)
+	if !errors.Is(err, flow.ErrUserLocked) {
+		t.Fatalf("got %v, want %v", err, flow.ErrUserLocked)
+	}
+}
+
+func TestTokensOfDeletedUserAreRejected(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t)
+	deleted := f.login(t, "alice")
+
+	err := f.service.DeleteUser(t.Context(), deleted.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a new user of the same name does not inherit the tokens
+	f.login(t, "alice")
+
+	_, err = f.service.GetUser(t.Context(), deleted.AccessToken)
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v, want %v", err, flow.ErrTokenInvalid)
+	}
+
+	for _, token := range []string{deleted.AccessToken, deleted.RefreshToken} {
+		introspection, err := f.service.IntrospectToken(t.Context(), token, flow.TokenTypeHintAccessToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if introspection.Active {
+			t.Fatalf("token of the deleted user is active: %+v", introspection)
+		}
+	}
+}
+
+func TestChangePasswordRejectsEarlierTokens(t *testing.T) {
+	t.Parallel()
+
+	const newPassword = "Battery-Staple-7?"
+
+	f := newFixture(t)
+	current := f.login(t, "alice")
+	other := f.login(t, "alice")
+
+	err := f.service.ChangePassword(t.Context(), &flow.PasswordChange{
+		Token: current.AccessToken, CurrentPassword: "wrong", NewPassword: newPassword,
+	})
+	if !errors.Is(err, flow.ErrUserUnauthorized) {
+		t.Fatalf("got %v for a wrong current password, want %v", err, flow.ErrUserUnauthorized)
+	}
+
+	err = f.service.ChangePassword(t.Context(), &flow.PasswordChange{
+		Token: current.AccessToken, CurrentPassword: password, NewPassword: newPassword,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the device that changed the password has to log in again like every other one
+	for _, tokenSet := range []*flow.TokenSetOutput{current, other} {
+		_, err = f.service.GetUser(t.Context(), tokenSet.AccessToken)
+		if !errors.Is(err, flow.ErrTokenInvalid) {
+			t.Fatalf("got %v for an access token issued before the change, want %v", err, flow.ErrTokenInvalid)
+		}
+
+		_, err = f.service.RefreshToken(t.Context(),
+			&flow.TokenSetInput{AccessToken: "", RefreshToken: tokenSet.RefreshToken})
+		if !errors.Is(err, flow.ErrTokenInvalid) {
+			t.Fatalf("got %v for a refresh token issued before the change, want %v", err, flow.ErrTokenInvalid)
+		}
+	}
+
+	_, err = f.service.CreateToken(t.Context(), &flow.Credential{Username: "alice", Password: password, Email: ""},
+		nil, nil)
+	if !errors.Is(err, flow.ErrUserUnauthorized) {
+		t.Fatalf("got %v for the old password, want %v", err, flow.ErrUserUnauthorized)
+	}
+
+	alice, err := f.service.CreateToken(t.Context(),
+		&flow.Credential{Username: "alice", Password: newPassword, Email: ""}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.GetUser(t.Context(), alice.AccessToken)
+	if err != nil {
+		t.Fatalf("got %v for a token issued after the change, want it to work", err)
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	t.Parallel()
+
+	const newPassword = "Battery-Staple-7?"
+
+	box := newMailbox()
+	f := newFixture(t, flow.WithPasswordReset(resettokenmemory.NewRepository(), box, time.Hour, "token:{token}"))
+
+	err := f.service.CreateUser(t.Context(),
+		&flow.Credential{Username: "alice", Password: password, Email: "alice@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := f.login(t, "alice")
+
+	err = f.service.RequestPasswordReset(t.Context(), "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := box.secret(t, "alice@example.com")
+
+	// a rejected password leaves the token for another try
+	err = f.service.ResetPassword(t.Context(), &flow.PasswordReset{Token: secret, NewPassword: "short"})
+	if !errors.Is(err, flow.ErrPasswordInvalid) {
+		t.Fatalf("got %v for a short password, want %v", err, flow.ErrPasswordInvalid)
+	}
+
+	err = f.service.ResetPassword(t.Context(), &flow.PasswordReset{Token: secret, NewPassword: newPassword})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = f.service.ResetPassword(t.Context(), &flow.PasswordReset{Token: secret, NewPassword: "Another-Fine-Day-7"})
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v for a used token, want %v", err, flow.ErrTokenInvalid)
+	}
+
+	_, err = f.service.CreateToken(t.Context(), &flow.Credential{Username: "alice", Password: password, Email: ""},
+		nil, nil)
+	if !errors.Is(err, flow.ErrUserUnauthorized) {
+		t.Fatalf("got %v for the old password, want %v", err, flow.ErrUserUnauthorized)
+	}
+
+	// the second try did not change the password either
+	_, err = f.service.CreateToken(t.Context(),
+		&flow.Credential{Username: "alice", Password: newPassword, Email: ""}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.RefreshToken(t.Context(),
+		&flow.TokenSetInput{AccessToken: "", RefreshToken: before.RefreshToken})
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v for a session opened before the reset, want %v", err, flow.ErrTokenInvalid)
+	}
+
+	err = f.service.ResetPassword(t.Context(), &flow.PasswordReset{Token: "unknown", NewPassword: newPassword})
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v for an unknown token, want %v", err, flow.ErrTokenInvalid)
+	}
+}
+
+func TestConcurrentResetsUseTheTokenOnce(t *testing.T) {
+	t.Parallel()
+
+	const workers = 8
+
+	box := newMailbox()
+	f := newFixture(t, flow.WithPasswordReset(resettokenmemory.NewRepository(), box, time.Hour, "token:{token}"))
+
+	err := f.service.CreateUser(t.Context(),
+		&flow.Credential{Username: "alice", Password: password, Email: "alice@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = f.service.RequestPasswordReset(t.Context(), "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := box.secret(t, "alice@example.com")
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded []string
+	)
+
+	for worker := range workers {
+		wg.Go(func() {
+			newPassword := "Battery-Staple-7?" + strconv.Itoa(worker)
+
+			err := f.service.ResetPassword(t.Context(), &flow.PasswordReset{Token: secret, NewPassword: newPassword})
+			switch {
+			case err == nil:
+				mu.Lock()
+				defer mu.Unlock()
+
+				succeeded = append(succeeded, newPassword)
+			case !errors.Is(err, flow.ErrTokenInvalid):
+				t.Errorf("got %v, want nil or %v", err, flow.ErrTokenInvalid)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if len(succeeded) != 1 {
+		t.Fatalf("the token reset the password %d times, want once", len(succeeded))
+	}
+
+	_, err = f.service.CreateToken(t.Context(),
+		&flow.Credential{Username: "alice", Password: succeeded[0], Email: ""}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRequestPasswordResetHidesMailFailures(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t, flow.WithPasswordReset(resettokenmemory.NewRepository(), brokenMailer{}, time.Hour, ""))
+
+	err := f.service.CreateUser(t.Context(),
+		&flow.Credential{Username: "alice", Password: password, Email: "alice@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an existing user whose mail fails looks like a missing user
+	for _, username := range []string{"alice", "bob"} {
+		err := f.service.RequestPasswordReset(t.Context(), username)
+		if err != nil {
+			t.Fatalf("%s: got %v, want nil", username, err)
+		}
+	}
+}
+
+func TestEndpointsRequireTheAudienceOfKeyStone(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t, flow.WithGrantProvider(grantsMap{
+		"alice": domain.NewGrant([]string{"billing"}, []string{"admin", "read"}),
+	}))
+	f.login(t, "bob")
+
+	credential := &flow.Credential{Username: "alice", Password: password, Email: ""}
+
+	err := f.service.CreateUser(t.Context(), credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		audience []string
+		want     error
+	}{
+		{name: "no audience is key-stone", audience: nil, want: nil},
+		{name: "key-stone", audience: []string{"key-stone"}, want: nil},
+		{name: "key-stone among others", audience: []string{"billing", "key-stone"}, want: nil},
+		{name: "another service", audience: []string{"billing"}, want: flow.ErrTokenInvalid},
+		{name: "empty audience", audience: []string{}, want: flow.ErrTokenInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			alice, err := f.service.CreateToken(t.Context(), credential, nil,
+				&flow.TokenRequest{Audience: tt.audience, Scope: nil})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = f.service.GetUser(t.Context(), alice.AccessToken)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v from the user endpoint, want %v", err, tt.want)
+			}
+
+			_, err = f.service.InspectUser(t.Context(), alice.AccessToken, "bob")
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v from the admin endpoint, want %v", err, tt.want)
+			}
+
+			// other services still introspect the tokens meant for them
+			introspection, err := f.service.IntrospectToken(t.Context(), alice.AccessToken, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !introspection.Active {
+				t.Fatal("the token is not active")
+			}
+		})
+	}
+}
+
+func TestTokenRequestIsNarrowedToTheGrant(t *testing.T) {
+	t.Parallel()
+
+	grants := grantsMap{"alice": domain.NewGrant([]string{"billing"}, []string{"read", "write"})}
+	f := newFixture(t, flow.WithGrantProvider(grants))
+	credential := &flow.Credential{Username: "alice", Password: password, Email: ""}
+
+	err := f.service.CreateUser(t.Context(), credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.CreateToken(t.Context(), credential, nil,
+		&flow.TokenRequest{Audience: []string{"billing", "shipping"}, Scope: nil})
+	if !errors.Is(err, flow.ErrAudienceNotAllowed) {
+		t.Fatalf("got %v for an audience that is not granted, want %v", err, flow.ErrAudienceNotAllowed)
+	}
+
+	alice, err := f.service.CreateToken(t.Context(), credential, nil,
+		&flow.TokenRequest{Audience: []string{"billing"}, Scope: []string{"read", "delete"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(alice.Scope, []string{"read"}) {
+		t.Fatalf("got scope %v, want [read]", alice.Scope)
+	}
+
+	all, err := f.service.CreateToken(t.Context(), credential, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(all.Scope, []string{"read", "write"}) {
+		t.Fatalf("got scope %v without a request, want every granted scope", all.Scope)
+	}
+
+	// the grant shrinks, so the next refresh narrows the session
+	grants["alice"] = domain.NewGrant(nil, []string{"write"})
+
+	refreshed, err := f.service.RefreshToken(t.Context(),
+		&flow.TokenSetInput{AccessToken: "", RefreshToken: alice.RefreshToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(refreshed.Scope) != 0 {
+		t.Fatalf("got scope %v after the grant shrank, want none", refreshed.Scope)
+	}
+
+	introspection, err := f.service.IntrospectToken(t.Context(), refreshed.AccessToken, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(introspection.Audience) != 0 {
+		t.Fatalf("got audience %v after the grant shrank, want none", introspection.Audience)
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t)
+	tokenSet := f.login(t, "alice")
+
+	displayName := "Alice Ü"
+	locale := "en-us"
+	attributes := map[string]string{"team": "名前", "empty": ""}
+
+	updated, err := f.service.UpdateUser(t.Context(), &flow.UserUpdate{
+		Token:       tokenSet.AccessToken,
+		DisplayName: &displayName,
+		Locale:      &locale,
+		Attributes:  attributes,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := f.service.GetUser(t.Context(), tokenSet.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, user := range []*flow.User{updated, got} {
+		if user.DisplayName != displayName || user.Locale != "en-US" || !maps.Equal(user.Attributes, attributes) {
+			t.Fatalf("got %q %q %v, want %q %q %v",
+				user.DisplayName, user.Locale, user.Attributes, displayName, "en-US", attributes)
+		}
+	}
+
+	// omitted fields are kept
+	_, err = f.service.UpdateUser(t.Context(), &flow.UserUpdate{Token: tokenSet.AccessToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = f.service.GetUser(t.Context(), tokenSet.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.DisplayName != displayName || got.Locale != "en-US" || !maps.Equal(got.Attributes, attributes) {
+		t.Fatalf("got %q %q %v after an empty update", got.DisplayName, got.Locale, got.Attributes)
+	}
+}
+
+func TestUpdateUserRefusesInvalidProfiles(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t)
+	tokenSet := f.login(t, "alice")
+
+	longName := strings.Repeat("a", 129)
+	invalidLocale := "not a language"
+	tooMany := make(map[string]string)
+
+	for i := range 33 {
+		tooMany[strconv.Itoa(i)] = ""
+	}
+
+	tests := []struct {
+		name   string
+		update *flow.UserUpdate
+	}{
+		{name: "long display name", update: &flow.UserUpdate{Token: tokenSet.AccessToken, DisplayName: &longName}},
+		{name: "invalid locale", update: &flow.UserUpdate{Token: tokenSet.AccessToken, Locale: &invalidLocale}},
+		{name: "too many attributes", update: &flow.UserUpdate{Token: tokenSet.AccessToken, Attributes: tooMany}},
+		{name: "empty attribute key", update: &flow.UserUpdate{
+			Token: tokenSet.AccessToken, Attributes: map[string]string{"": "value"},
+		}},
+		{name: "long attribute value", update: &flow.UserUpdate{
+			Token: tokenSet.AccessToken, Attributes: map[string]string{"key": strings.Repeat("a", 1025)},
+		}},
+	}
+
+	for _, tt := range tests {
+		_, err := f.service.UpdateUser(t.Context(), tt.update)
+		if !errors.Is(err, flow.ErrProfileInvalid) {
+			t.Fatalf("%s: got %v, want %v", tt.name, err, flow.ErrProfileInvalid)
+		}
+	}
+
+	// a refused update stores nothing
+	got, err := f.service.GetUser(t.Context(), tokenSet.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.DisplayName != "" || got.Locale != "" || len(got.Attributes) != 0 {
+		t.Fatalf("got %q %q %v, want an empty profile", got.DisplayName, got.Locale, got.Attributes)
+	}
+}
+
+func TestUpdatedAtFollowsChangesOfTheUser(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture(t, flow.WithLockoutPolicy(domain.NewLockoutPolicy().WithMaxFailedLogins(5)))
+	tokenSet := f.login(t, "alice")
+
+	before, err := f.service.GetUser(t.Context(), tokenSet.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// failed and successful logins are bookkeeping, not changes of the user
+	_, err = f.service.CreateToken(t.Context(), &flow.Credential{Username: "alice", Password: "wrong", Email: ""},
+		nil, nil)
+	if !errors.Is(err, flow.ErrUserUnauthorized) {
+		t.Fatalf("got %v, want %v", err, flow.ErrUserUnauthorized)
+	}
+
+	tokenSet = f.login(t, "alice")
+
+	got, err := f.service.GetUser(t.Context(), tokenSet.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !got.UpdatedAt.Equal(before.UpdatedAt) {
+		t.Fatalf("got updated at %v after logins, want %v", got.UpdatedAt, before.UpdatedAt)
+	}
+
+	err = f.service.ChangePassword(t.Context(), &flow.PasswordChange{
+		Token:           tokenSet.AccessToken,
+		CurrentPassword: password,
+		NewPassword:     "Battery-Staple-7?",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenSet, err = f.service.CreateToken(t.Context(),
+		&flow.Credential{Username: "alice", Password: "Battery-Staple-7?", Email: ""}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = f.service.GetUser(t.Context(), tokenSet.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !got.UpdatedAt.After(before.UpdatedAt) || !got.CreatedAt.Equal(before.CreatedAt) {
+		t.Fatalf("got created at %v, updated at %v after a password change, want created at %v, updated after %v",
+			got.CreatedAt, got.UpdatedAt, before.CreatedAt, before.UpdatedAt)
+	}
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	box := newMailbox()
+	f := newFixture(t,
+		flow.WithEmailVerification(verificationmemory.NewRepository(), box, time.Hour, "token:{token}"),
+		flow.WithVerifiedEmailRequired(),
+	)
+	credential := &flow.Credential{Username: "alice", Password: password, Email: "alice@example.com"}
+
+	err := f.service.CreateUser(t.Context(), credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.CreateToken(t.Context(), credential, nil, nil)
+	if !errors.Is(err, flow.ErrEmailNotVerified) {
+		t.Fatalf("got %v before the verification, want %v", err, flow.ErrEmailNotVerified)
+	}
+
+	secret := box.secret(t, "alice@example.com")
+
+	err = f.service.VerifyEmail(t.Context(), secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.CreateToken(t.Context(), credential, nil, nil)
+	if err != nil {
+		t.Fatalf("got %v after the verification, want nil", err)
+	}
+
+	err = f.service.VerifyEmail(t.Context(), secret)
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v for a used token, want %v", err, flow.ErrTokenInvalid)
+	}
+}
+
+func TestUsersWithoutEmailCanSetOne(t *testing.T) {
+	t.Parallel()
+
+	box := newMailbox()
+	f := newFixture(t,
+		flow.WithEmailVerification(verificationmemory.NewRepository(), box, time.Hour, "token:{token}"),
+		flow.WithVerifiedEmailRequired(),
+	)
+
+	hash, err := newHasher().Hash(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = f.service.ImportUser(t.Context(), &flow.ImportedUser{
+		Username: "alice", PasswordHash: hash, Email: "", EmailVerified: false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credential := &flow.Credential{Username: "alice", Password: password, Email: ""}
+
+	// a user without an email is not locked out
+	tokenSet, err := f.service.CreateToken(t.Context(), credential, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	empty := ""
+
+	_, err = f.service.UpdateUser(t.Context(), &flow.UserUpdate{Token: tokenSet.AccessToken, Email: &empty})
+	if !errors.Is(err, flow.ErrEmailInvalid) {
+		t.Fatalf("got %v for an empty email, want %v", err, flow.ErrEmailInvalid)
+	}
+
+	email := "alice@example.com"
+
+	user, err := f.service.UpdateUser(t.Context(), &flow.UserUpdate{Token: tokenSet.AccessToken, Email: &email})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.Email != email || user.EmailVerified {
+		t.Fatalf("got email %q, verified %v, want %q unverified", user.Email, user.EmailVerified, email)
+	}
+
+	first := box.secret(t, email)
+
+	_, err = f.service.CreateToken(t.Context(), credential, nil, nil)
+	if !errors.Is(err, flow.ErrEmailNotVerified) {
+		t.Fatalf("got %v with an unverified email, want %v", err, flow.ErrEmailNotVerified)
+	}
+
+	// a token mailed to a replaced email does not verify the new one
+	changed := "alice@example.org"
+
+	_, err = f.service.UpdateUser(t.Context(), &flow.UserUpdate{Token: tokenSet.AccessToken, Email: &changed})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = f.service.VerifyEmail(t.Context(), first)
+	if !errors.Is(err, flow.ErrTokenInvalid) {
+		t.Fatalf("got %v for the token of the replaced email, want %v", err, flow.ErrTokenInvalid)
+	}
+
+	err = f.service.VerifyEmail(t.Context(), box.secret(t, changed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.CreateToken(t.Context(), credential, nil, nil)
+	if err != nil {
+		t.Fatalf("got %v after the verification, want nil", err)
+	}
+
+	// setting the same email again keeps the verification and mails nothing
+	user, err = f.service.UpdateUser(t.Context(), &flow.UserUpdate{Token: tokenSet.AccessToken, Email: &changed})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !user.EmailVerified || box.count(changed) != 1 {
+		t.Fatalf("got verified %v and %d mails, want verified and 1 mail", user.EmailVerified, box.count(changed))
+	}
+}
+
+func TestSetUserEmail(t *testing.T) {
+	t.Parallel()
+
+	box := newMailbox()
+	f := newFixture(t,
+		flow.WithGrantProvider(grantsMap{"carol": domain.NewGrant(nil, []string{"admin"})}),
+		flow.WithPasswordReset(resettokenmemory.NewRepository(), box, time.Hour, "token:{token}"),
+		flow.WithEmailVerification(verificationmemory.NewRepository(), box, time.Hour, "token:{token}"),
+	)
+	admin := f.login(t, "carol")
+	user := f.login(t, "alice")
+
+	err := f.service.ForcePasswordReset(t.Context(), admin.AccessToken, "alice")
+	if !errors.Is(err, flow.ErrEmailInvalid) {
+		t.Fatalf("got %v for a user without an email, want %v", err, flow.ErrEmailInvalid)
+	}
+
+	err = f.service.SetUserEmail(t.Context(), &flow.EmailChange{
+		Token: user.AccessToken, Username: "alice", Email: "alice@example.com", Verified: true,
+	})
+	if !errors.Is(err, flow.ErrForbidden) {
+		t.Fatalf("got %v without the admin scope, want %v", err, flow.ErrForbidden)
+	}
+
+	err = f.service.SetUserEmail(t.Context(), &flow.EmailChange{
+		Token: admin.AccessToken, Username: "alice", Email: "alice@example.com", Verified: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := f.service.InspectUser(t.Context(), admin.AccessToken, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an email the admin vouches for is not mailed a verification token
+	if got.Email != "alice@example.com" || !got.EmailVerified || box.count("alice@example.com") != 0 {
+		t.Fatalf("got email %q, verified %v and %d mails, want alice@example.com verified without mails",
+			got.Email, got.EmailVerified, box.count("alice@example.com"))
+	}
+
+	err = f.service.ForcePasswordReset(t.Context(), admin.AccessToken, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = f.service.ResetPassword(t.Context(), &flow.PasswordReset{
+		Token: box.secret(t, "alice@example.com"), NewPassword: "Battery-Staple-7?",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = f.service.SetUserEmail(t.Context(), &flow.EmailChange{
+		Token: admin.AccessToken, Username: "alice", Email: "alice@example.org", Verified: false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if box.count("alice@example.org") != 1 {
+		t.Fatalf("got %d verification mails, want 1", box.count("alice@example.org"))
+	}
+
+	err = f.service.SetUserEmail(t.Context(), &flow.EmailChange{
+		Token: admin.AccessToken, Username: "bob", Email: "bob@example.com", Verified: true,
+	})
+	if !errors.Is(err, flow.ErrUserNotFound) {
+		t.Fatalf("got %v for a missing user, want %v", err, flow.ErrUserNotFound)
+	}
+}
+
+func TestAdminEndpointsRequireTheAdminScopeOrRole(t *testing.T) {
+	t.Parallel()
+
+	options := []flow.Option{
+		flow.WithGrantProvider(grantsMap{"carol": domain.NewGrant(nil, []string{"admin"})}),
+		flow.WithClaimsProvider(claimsMap{"dave": {"roles": []any{"admin"}}}),
+	}
+
+	f := newFixture(t, options...)
+	user := f.login(t, "alice")
+	bob := f.login(t, "bob")
+
+	endpoints := map[string]func(token string) error{
+		"list": func(token string) error {
+			_, err := f.service.ListUsers(t.Context(), &flow.UserQuery{Token: token, After: "", Search: "", Limit: 10})
+
+			return err
+		},
+		"inspect": func(token string) error {
+			_, err := f.service.InspectUser(t.Context(), token, "bob")
+
+			return err
+		},
+		"disable": func(token string) error {
+			return f.service.DisableUser(t.Context(), token, "bob")
+		},
+		"enable": func(token string) error {
+			return f.service.EnableUser(t.Context(), token, "bob")
+		},
+		"unlock": func(token string) error {
+			return f.service.UnlockUser(t.Context(), token, "bob")
+		},
+		"set email": func(token string) error {
+			return f.service.SetUserEmail(t.Context(), &flow.EmailChange{
+				Token: token, Username: "bob", Email: "bob@example.com", Verified: true,
+			})
+		},
+		"force delete": func(token string) error {
+			return f.service.ForceDeleteUser(t.Context(), token, "bob")
+		},
+	}
+
+	for name, call := range endpoints {
+		err := call(user.AccessToken)
+		if !errors.Is(err, flow.ErrForbidden) {
+			t.Fatalf("%s: got %v without the admin scope or role, want %v", name, err, flow.ErrForbidden)
+		}
+	}
+
+	// nothing happened to bob
+	_, err := f.service.GetUser(t.Context(), bob.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byScope := f.login(t, "carol")
+	byRole := f.login(t, "dave")
+
+	for _, admin := range []*flow.TokenSetOutput{byScope, byRole} {
+		_, err := f.service.InspectUser(t.Context(), admin.AccessToken, "bob")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// an empty role and scope allow nobody
+	locked := newFixture(t, append(options, flow.WithAdminAccess("", ""))...)
+
+	for _, username := range []string{"carol", "dave"} {
+		admin := locked.login(t, username)
+
+		_, err := locked.service.InspectUser(t.Context(), admin.AccessToken, username)
+		if !errors.Is(err, flow.ErrForbidden) {
+			t.Fatalf("%s: got %v without admin access, want %v", username, err, flow.ErrForbidden)
+		}
+	}
+}
+
+func TestUnlockUserLiftsTheLockout(t *testing.T) {
+	t.Parallel()
+
+	const maxFailedLogins = 3
+
+	f := newFixture(t,
+		flow.WithGrantProvider(grantsMap{"carol": domain.NewGrant(nil, []string{"admin"})}),
+		flow.WithLockoutPolicy(domain.NewLockoutPolicy().WithMaxFailedLogins(maxFailedLogins).WithDuration(time.Hour)),
+	)
+	admin := f.login(t, "carol")
+	user := f.login(t, "bob")
+	f.login(t, "alice")
+
+	for range maxFailedLogins {
+		_, err := f.service.CreateToken(t.Context(), &flow.Credential{Username: "alice", Password: "wrong", Email: ""},
+			nil, nil)
+		if !errors.Is(err, flow.ErrUserUnauthorized) {
+			t.Fatalf("got %v, want %v", err, flow.ErrUserUnauthorized)
+		}
+	}
+
+	alice := &flow.Credential{Username: "alice", Password: password, Email: ""}
+
+	_, err := f.service.CreateToken(t.Context(), alice, nil, nil)
+	if !errors.Is(err, flow.ErrUserLocked) {
+		t.Fatalf("got %v, want %v", err, flow.ErrUserLocked)
+	}
+
+	err = f.service.UnlockUser(t.Context(), user.AccessToken, "alice")
+	if !errors.Is(err, flow.ErrForbidden) {
+		t.Fatalf("got %v for a user, want %v", err, flow.ErrForbidden)
+	}
+
+	err = f.service.UnlockUser(t.Context(), admin.AccessToken, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.service.CreateToken(t.Context(), alice, nil, nil)
+	if err != nil {
+		t.Fatalf("got %v after the unlock, want the login to work", err)
+	}
+
+	err = f.service.UnlockUser(t.Context(), admin.AccessToken, "nobody")
+	if !errors.Is(err, flow.ErrUserNotFound) {
+		t.Fatalf("got %v for a missing user, want %v", err, flow.ErrUserNotFound)
+	}
+}
+
+func TestLockout(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxFailedLogins = 3
+		duration        = 500 * time.Millisecond
+	)
+
+	f := newFixture(t,
+		flow.WithLockoutPolicy(domain.NewLockoutPolicy().WithMaxFailedLogins(maxFailedLogins).WithDuration(duration)),
+	)
+	f.login(t, "alice")
+
+	wrong := &flow.Credential{Username: "alice", Password: "wrong", Email: ""}
+	right := &flow.Credential{Username: "alice", Password: password, Email: ""}
+
+	fail := func(times int) {
+		t.Helper()
+
+		for range times {
+			_, err := f.service.CreateToken(t.Context(), wrong, nil, nil)
+			if !errors.Is(err, flow.ErrUserUnauthorized) {
+				t.Fatalf("got %v, want %v", err, flow.ErrUserUnauthorized)
+			}
+		}
+	}
+
+	// a successful login starts the count over, so only failures in a row lock the user
+	fail(maxFailedLogins - 1)
+	f.login(t, "alice")
+	fail(maxFailedLogins - 1)
+	f.login(t, "alice")
+
+	fail(maxFailedLogins)
+
+	lockedAt := time.Now()
+
+	// the right password is refused too while the lockout lasts
+	for _, credential := range []*flow.Credential{right, wrong} {
+		_, err := f.service.CreateToken(t.Context(), credential, nil, nil)
+		if !errors.Is(err, flow.ErrUserLocked) {
+			t.Fatalf("got %v during the lockout, want %v", err, flow.ErrUserLocked)
+		}
+	}
+
+	time.Sleep(time.Until(lockedAt.Add(duration)))
+
+	// the lockout ends on its own and leaves no failed logins behind
+	fail(maxFailedLogins - 1)
+	f.login(t, "alice")
+}
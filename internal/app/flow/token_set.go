@@ -5,8 +5,27 @@ type TokenSetInput struct {
 	RefreshToken string
 }
 
+// TokenRequest narrows the tokens being issued. The audience must be granted to the user,
+// the scope is restricted to the granted scopes. A nil scope requests every granted scope.
+type TokenRequest struct {
+	Audience []string
+	Scope    []string
+}
+
 type TokenSetOutput struct {
 	AccessToken  string
 	RefreshToken string
 	ExpiresIn    int
+	Scope        []string
 }
+
+// TokenTypeHint tells which kind of token a revoked token probably is.
+type TokenTypeHint string
+
+const (
+	TokenTypeHintAccessToken  TokenTypeHint = "access_token"
+	TokenTypeHintRefreshToken TokenTypeHint = "refresh_token"
+)
+
+// TokenTypeBearer is the type of access tokens as in RFC 6749 section 7.1. Refresh tokens have no type.
+const TokenTypeBearer = "Bearer"
@@ -0,0 +1,50 @@
+package flow
+
+import "time"
+
+// User is the profile of a user with the email and the state of the credential.
+type User struct {
+	Username      string
+	Email         string
+	EmailVerified bool
+	Disabled      bool
+	LockedUntil   time.Time // zero unless the user is locked out now
+	DisplayName   string
+	Locale        string
+	Attributes    map[string]string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// UserUpdate changes the profile and the email of the user of the access token. Nil fields are left as they are,
+// given attributes replace every attribute.
+type UserUpdate struct {
+	Token       string
+	DisplayName *string
+	Locale      *string
+	Attributes  map[string]string
+	Email       *string
+}
+
+// EmailChange sets the email of a user for an admin. Unless Verified, the email has to be verified by the user.
+type EmailChange struct {
+	Token    string
+	Username string
+	Email    string
+	Verified bool
+}
+
+// UserQuery lists the users whose username or email contains Search, in the order of their usernames.
+// After is the Next of the previous page, empty for the first page. Limit is the size of a page, at least 1.
+type UserQuery struct {
+	Token  string
+	After  string
+	Search string
+	Limit  int
+}
+
+// UserPage is a page of users. Next is empty on the last page.
+type UserPage struct {
+	Users []*User
+	Next  string
+}
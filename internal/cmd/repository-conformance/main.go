@@ -0,0 +1,132 @@
+// Command repository-conformance verifies every credential repository against repositorytest.
+// Build it with the race detector, see make conformance. PostgreSQL is only verified if KS_REPOSITORY_DSN is set.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/boltdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/bolt"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/file"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/memory"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/postgres"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/repositorytest"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/sqlite"
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+)
+
+func main() {
+	err := run(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context) error {
+	dir, err := os.MkdirTemp("", "repository-stress-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	repos, closeRepositories, err := openRepositories(ctx, dir)
+	if err != nil {
+		return err
+	}
+
+	defer closeRepositories()
+
+	var errs []error
+
+	for name, repo := range repos {
+		err := repositorytest.Verify(ctx, repo)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+
+			continue
+		}
+
+		log.Printf("%s: ok", name)
+	}
+
+	return errors.Join(errs...)
+}
+
+// openRepositories opens every repository below dir, closeRepositories closes them again.
+func openRepositories(
+	ctx context.Context,
+	dir string,
+) (map[string]credentialrepository.Repository, func(), error) {
+	var closers []func()
+
+	closeRepositories := func() {
+		for _, closeRepository := range slices.Backward(closers) {
+			closeRepository()
+		}
+	}
+
+	fail := func(err error) (map[string]credentialrepository.Repository, func(), error) {
+		closeRepositories()
+
+		return nil, nil, err
+	}
+
+	fileRepo, err := file.NewRepository(filepath.Join(dir, "file"))
+	if err != nil {
+		return fail(fmt.Errorf("failed to create file repository: %w", err))
+	}
+
+	sqliteRepo, err := sqlite.NewRepository(ctx, filepath.Join(dir, "sqlite", "credentials.db"))
+	if err != nil {
+		return fail(fmt.Errorf("failed to create sqlite repository: %w", err))
+	}
+
+	closers = append(closers, func() { _ = sqliteRepo.Close() })
+
+	db, err := boltdb.Open(filepath.Join(dir, "bolt", "key-stone.db"))
+	if err != nil {
+		return fail(fmt.Errorf("failed to open bolt database: %w", err))
+	}
+
+	closers = append(closers, func() { _ = db.Close() })
+
+	boltRepo, err := bolt.NewRepository(db)
+	if err != nil {
+		return fail(fmt.Errorf("failed to create bolt repository: %w", err))
+	}
+
+	repos := map[string]credentialrepository.Repository{
+		"memory": memory.NewRepository(),
+		"file":   fileRepo,
+		"sqlite": sqliteRepo,
+		"bolt":   boltRepo,
+	}
+
+	if dsn := os.Getenv("KS_REPOSITORY_DSN"); dsn != "" {
+		pool, err := postgresdb.Open(ctx, dsn)
+		if err != nil {
+			return fail(fmt.Errorf("failed to open postgres database: %w", err))
+		}
+
+		closers = append(closers, pool.Close)
+
+		postgresRepo, err := postgres.NewRepository(ctx, pool)
+		if err != nil {
+			return fail(fmt.Errorf("failed to create postgres repository: %w", err))
+		}
+
+		repos["postgres"] = postgresRepo
+	}
+
+	return repos, closeRepositories, nil
+}
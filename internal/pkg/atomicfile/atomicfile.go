@@ -0,0 +1,85 @@
+// Package atomicfile writes files through a synced temporary file in the same directory,
+// so readers and crashes never observe a partially written file.
+package atomicfile
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// Create writes data to a new file at path.
+// It fails with an error satisfying os.IsExist if the file already exists.
+func Create(path string, data []byte) error {
+	return write(path, data, func(temp, path string) error {
+		// a hard link fails when the target exists, which makes the create exclusive
+		return os.Link(temp, path) //nolint:wrapcheck
+	})
+}
+
+// Write writes data to the file at path, replacing it if it exists.
+func Write(path string, data []byte) error {
+	return write(path, data, func(temp, path string) error {
+		return os.Rename(temp, path) //nolint:wrapcheck
+	})
+}
+
+func write(path string, data []byte, publish func(temp, path string) error) error {
+	dir := filepath.Dir(path)
+
+	file, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	defer func() {
+		err := os.Remove(file.Name())
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("failed to remove temporary file: %v", err)
+		}
+	}()
+
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+
+	closeErr := file.Close()
+	if err != nil {
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if closeErr != nil {
+		return fmt.Errorf("failed to close temporary file: %w", closeErr)
+	}
+
+	err = publish(file.Name(), path)
+	if err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+// syncDir persists the directory entry of a published file.
+func syncDir(dir string) error {
+	file, err := os.Open(filepath.Clean(dir))
+	if err != nil {
+		return fmt.Errorf("failed to open directory: %w", err)
+	}
+
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("failed to close directory: %v", err)
+		}
+	}()
+
+	err = file.Sync()
+	if err != nil {
+		return fmt.Errorf("failed to sync directory: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Package boltdb opens the bbolt file shared by the bolt repositories.
+//
+// Every repository owns one top-level bucket named after what it stores, e.g. "credentials",
+// "sessions" or "revocations", so they live in the same file without touching each other.
+// The "meta" bucket belongs to this package and records the layout version.
+package boltdb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Version is the layout version written by this package.
+const Version = 1
+
+var (
+	bucketMeta = []byte("meta")    //nolint:gochecknoglobals
+	keyVersion = []byte("version") //nolint:gochecknoglobals
+)
+
+// Open opens the bbolt file at path, creating it if needed.
+// It fails instead of waiting forever if another process holds the file.
+func Open(path string) (*bolt.DB, error) {
+	const (
+		createPerm = 0750
+		openPerm   = 0600
+		timeout    = 5 * time.Second
+	)
+
+	err := os.MkdirAll(filepath.Dir(path), createPerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	db, err := bolt.Open(path, openPerm, &bolt.Options{Timeout: timeout}) //nolint:exhaustruct
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	err = db.Update(checkVersion)
+	if err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func checkVersion(tx *bolt.Tx) error {
+	meta, err := tx.CreateBucketIfNotExists(bucketMeta)
+	if err != nil {
+		return fmt.Errorf("failed to create meta bucket: %w", err)
+	}
+
+	if data := meta.Get(keyVersion); data != nil {
+		version := binary.BigEndian.Uint64(data)
+		if version > Version {
+			return fmt.Errorf("layout version %d: %w", version, ErrUnknownVersion)
+		}
+	}
+
+	err = meta.Put(keyVersion, binary.BigEndian.AppendUint64(nil, Version))
+	if err != nil {
+		return fmt.Errorf("failed to write layout version: %w", err)
+	}
+
+	return nil
+}
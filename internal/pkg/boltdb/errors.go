@@ -0,0 +1,5 @@
+package boltdb
+
+import "errors"
+
+var ErrUnknownVersion = errors.New("database was created by a newer version")
@@ -0,0 +1,43 @@
+package bloom
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/breachchecker"
+)
+
+var _ breachchecker.Checker = (*Checker)(nil)
+
+// Checker looks passwords up in a Bloom filter of their SHA-1 hashes, which is held in memory.
+// It is much smaller than the list of hashes, but rejects a few passwords that were never breached.
+type Checker struct {
+	filter *Filter
+}
+
+func NewChecker(path string) (*Checker, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open filter: %w", err)
+	}
+	defer file.Close()
+
+	filter, err := ReadFilter(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Checker{filter: filter}, nil
+}
+
+func (c *Checker) Breached(ctx context.Context, password string) (bool, error) {
+	err := ctx.Err()
+	if err != nil {
+		return false, fmt.Errorf("failed to check breached passwords: %w", err)
+	}
+
+	return c.filter.Contains(sha1.Sum([]byte(password))), nil //nolint:gosec
+}
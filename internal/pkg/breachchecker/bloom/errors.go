@@ -0,0 +1,5 @@
+package bloom
+
+import "errors"
+
+var ErrInvalidFilter = errors.New("not a bloom filter file")
@@ -0,0 +1,134 @@
+package bloom
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// magic starts every filter file, followed by the number of bits and hash functions and the bits.
+const magic = "KSBLOOM1"
+
+// Filter is a Bloom filter of SHA-1 digests. The digests are already uniformly distributed, so the
+// positions of a digest are derived from two of its 64 bit halves by double hashing.
+type Filter struct {
+	bits   []uint64
+	size   uint64
+	hashes uint32
+}
+
+// NewFilter sizes a filter for n digests with the given false positive rate.
+func NewFilter(n uint64, falsePositiveRate float64) *Filter {
+	n = max(n, 1)
+	size := uint64(math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	size = max(size, 64) //nolint:mnd
+	hashes := uint32(max(math.Round(float64(size)/float64(n)*math.Ln2), 1))
+
+	return &Filter{
+		bits:   make([]uint64, (size+63)/64), //nolint:mnd
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+func (f *Filter) Add(digest [sha1.Size]byte) {
+	for position := range f.positions(digest) {
+		f.bits[position/64] |= 1 << (position % 64) //nolint:mnd
+	}
+}
+
+// Contains reports whether the digest may have been added. It is never wrong about digests that were added.
+func (f *Filter) Contains(digest [sha1.Size]byte) bool {
+	for position := range f.positions(digest) {
+		if f.bits[position/64]&(1<<(position%64)) == 0 { //nolint:mnd
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *Filter) positions(digest [sha1.Size]byte) func(yield func(uint64) bool) {
+	h1 := binary.BigEndian.Uint64(digest[0:8])
+	h2 := binary.BigEndian.Uint64(digest[8:16]) | 1
+
+	return func(yield func(uint64) bool) {
+		for i := range uint64(f.hashes) {
+			if !yield((h1 + i*h2) % f.size) {
+				return
+			}
+		}
+	}
+}
+
+func (f *Filter) WriteTo(writer io.Writer) (int64, error) {
+	buffered := bufio.NewWriter(writer)
+
+	header := binary.LittleEndian.AppendUint64([]byte(magic), f.size)
+	header = binary.LittleEndian.AppendUint32(header, f.hashes)
+
+	written, err := buffered.Write(header)
+	if err != nil {
+		return int64(written), fmt.Errorf("failed to write filter: %w", err)
+	}
+
+	var word [8]byte
+
+	for _, bits := range f.bits {
+		binary.LittleEndian.PutUint64(word[:], bits)
+
+		n, err := buffered.Write(word[:])
+		written += n
+
+		if err != nil {
+			return int64(written), fmt.Errorf("failed to write filter: %w", err)
+		}
+	}
+
+	err = buffered.Flush()
+	if err != nil {
+		return int64(written), fmt.Errorf("failed to write filter: %w", err)
+	}
+
+	return int64(written), nil
+}
+
+func ReadFilter(reader io.Reader) (*Filter, error) {
+	buffered := bufio.NewReader(reader)
+
+	header := make([]byte, len(magic)+8+4) //nolint:mnd
+
+	_, err := io.ReadFull(buffered, header)
+	if err != nil || string(header[:len(magic)]) != magic {
+		return nil, ErrInvalidFilter
+	}
+
+	size := binary.LittleEndian.Uint64(header[len(magic):])
+	hashes := binary.LittleEndian.Uint32(header[len(magic)+8:])
+
+	if size == 0 || hashes == 0 {
+		return nil, ErrInvalidFilter
+	}
+
+	bits := make([]uint64, (size+63)/64) //nolint:mnd
+
+	var word [8]byte
+
+	for i := range bits {
+		_, err := io.ReadFull(buffered, word[:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read filter: %w", ErrInvalidFilter)
+		}
+
+		bits[i] = binary.LittleEndian.Uint64(word[:])
+	}
+
+	return &Filter{
+		bits:   bits,
+		size:   size,
+		hashes: hashes,
+	}, nil
+}
@@ -0,0 +1,173 @@
+package bloom_test
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/breachchecker/bloom"
+)
+
+func digest(password string) [sha1.Size]byte {
+	return sha1.Sum([]byte(password)) //nolint:gosec
+}
+
+func TestFilterHasNoFalseNegatives(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		n                 int
+		falsePositiveRate float64
+	}{
+		{name: "one digest", n: 1, falsePositiveRate: 0.01},
+		{name: "many digests", n: 10000, falsePositiveRate: 0.001},
+		{name: "undersized", n: 10000, falsePositiveRate: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter := bloom.NewFilter(uint64(tt.n), tt.falsePositiveRate)
+			for i := range tt.n {
+				filter.Add(digest(fmt.Sprintf("password-%d", i)))
+			}
+
+			var buf bytes.Buffer
+
+			_, err := filter.WriteTo(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			read, err := bloom.ReadFilter(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i := range tt.n {
+				if !filter.Contains(digest(fmt.Sprintf("password-%d", i))) {
+					t.Fatalf("password-%d is missing", i)
+				}
+
+				if !read.Contains(digest(fmt.Sprintf("password-%d", i))) {
+					t.Fatalf("password-%d is missing after reading the filter back", i)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterFalsePositiveRate(t *testing.T) {
+	t.Parallel()
+
+	const n = 10000
+
+	filter := bloom.NewFilter(n, 0.01)
+	for i := range n {
+		filter.Add(digest(fmt.Sprintf("password-%d", i)))
+	}
+
+	falsePositives := 0
+
+	for i := range n {
+		if filter.Contains(digest(fmt.Sprintf("never-added-%d", i))) {
+			falsePositives++
+		}
+	}
+
+	// 1% expected, 3% leaves room for chance
+	if falsePositives > n*3/100 {
+		t.Fatalf("got %d false positives in %d, want about 1%%", falsePositives, n)
+	}
+}
+
+func TestReadFilterInvalid(t *testing.T) {
+	t.Parallel()
+
+	var valid bytes.Buffer
+
+	_, err := bloom.NewFilter(100, 0.01).WriteTo(&valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "other magic", data: append([]byte("KSBLOOM0"), valid.Bytes()[8:]...)},
+		{name: "truncated header", data: valid.Bytes()[:10]},
+		{name: "truncated bits", data: valid.Bytes()[:valid.Len()-1]},
+		{name: "no bits", data: append([]byte("KSBLOOM1"), make([]byte, 12)...)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := bloom.ReadFilter(bytes.NewReader(tt.data))
+			if !errors.Is(err, bloom.ErrInvalidFilter) {
+				t.Fatalf("got %v, want %v", err, bloom.ErrInvalidFilter)
+			}
+		})
+	}
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	filter := bloom.NewFilter(3, 0.0001)
+	for _, password := range []string{"password", "123456", "Correct-Horse-9!"} {
+		filter.Add(digest(password))
+	}
+
+	path := filepath.Join(t.TempDir(), "breached.bloom")
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = filter.WriteTo(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = file.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checker, err := bloom.NewChecker(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{password: "password", want: true},
+		{password: "123456", want: true},
+		{password: "Correct-Horse-9!", want: true},
+		{password: "Password", want: false},
+	}
+
+	for _, tt := range tests {
+		got, err := checker.Breached(t.Context(), tt.password)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != tt.want {
+			t.Fatalf("%q: got %t, want %t", tt.password, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package breachchecker
+
+import "context"
+
+// Checker tells whether a password appears in a corpus of breached passwords.
+// Checkers work offline, the corpus is read from disk.
+type Checker interface {
+	Breached(ctx context.Context, password string) (bool, error)
+}
@@ -0,0 +1,167 @@
+package hibp
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/breachchecker"
+)
+
+var _ breachchecker.Checker = (*Checker)(nil)
+
+// prefixLength is the length of the SHA-1 prefixes of the k-anonymity range API.
+const prefixLength = 5
+
+// Checker looks passwords up in a Have I Been Pwned download of SHA-1 hashes. The download is either
+//
+//   - a directory of range files named by a 5 character prefix, e.g. 21BD1.txt, with lines of the
+//     remaining 35 characters and the count, e.g. 0018A45C4D1DEF81644B54AB7F969B88D65:1
+//   - a single file ordered by hash with lines of the full hash and the count, which is binary searched
+//
+// The file is opened for every lookup, so it may be replaced while the server runs.
+type Checker struct {
+	path string
+	dir  bool
+}
+
+func NewChecker(path string) (*Checker, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat breached passwords: %w", err)
+	}
+
+	return &Checker{
+		path: filepath.Clean(path),
+		dir:  info.IsDir(),
+	}, nil
+}
+
+func (c *Checker) Breached(ctx context.Context, password string) (bool, error) {
+	err := ctx.Err()
+	if err != nil {
+		return false, fmt.Errorf("failed to check breached passwords: %w", err)
+	}
+
+	digest := sha1.Sum([]byte(password)) //nolint:gosec
+	hash := strings.ToUpper(hex.EncodeToString(digest[:]))
+
+	if c.dir {
+		return c.searchRange(hash)
+	}
+
+	return c.searchOrdered(hash)
+}
+
+func (c *Checker) searchRange(hash string) (bool, error) {
+	file, err := os.Open(filepath.Join(c.path, hash[:prefixLength]+".txt"))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to open range file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		suffix, _, _ := strings.Cut(scanner.Text(), ":")
+		if strings.EqualFold(suffix, hash[prefixLength:]) {
+			return true, nil
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return false, fmt.Errorf("failed to read range file: %w", err)
+	}
+
+	return false, nil
+}
+
+// searchOrdered binary searches the line starts of the ordered file, which may be tens of gigabytes.
+func (c *Checker) searchOrdered(hash string) (bool, error) {
+	file, err := os.Open(c.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open breached passwords: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return false, fmt.Errorf("failed to stat breached passwords: %w", err)
+	}
+
+	// lo is always the start of a line, the line of the hash starts in [lo, hi)
+	lo, hi := int64(0), info.Size()
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+
+		start, line, err := lineAt(file, mid, info.Size())
+		if err != nil {
+			return false, err
+		}
+
+		if start >= hi {
+			hi = mid
+
+			continue
+		}
+
+		lineHash, _, _ := strings.Cut(line, ":")
+
+		switch compare := strings.Compare(strings.ToUpper(lineHash), hash); {
+		case compare == 0:
+			return true, nil
+		case compare < 0:
+			lo = start + int64(len(line)) + 1
+		default:
+			hi = mid
+		}
+	}
+
+	return false, nil
+}
+
+// maxLine is more than a line of a hash and a count takes.
+const maxLine = 128
+
+// lineAt returns the first line starting at or after pos, without its newline.
+func lineAt(file io.ReaderAt, pos int64, size int64) (int64, string, error) {
+	start := pos
+
+	if pos > 0 {
+		buf := make([]byte, maxLine)
+
+		n, err := file.ReadAt(buf, pos-1)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, "", fmt.Errorf("failed to read breached passwords: %w", err)
+		}
+
+		end := strings.IndexByte(string(buf[:n]), '\n')
+		if end < 0 {
+			return size, "", nil
+		}
+
+		start = pos + int64(end)
+	}
+
+	buf := make([]byte, maxLine)
+
+	n, err := file.ReadAt(buf, start)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return 0, "", fmt.Errorf("failed to read breached passwords: %w", err)
+	}
+
+	line, _, _ := strings.Cut(string(buf[:n]), "\n")
+
+	return start, line, nil
+}
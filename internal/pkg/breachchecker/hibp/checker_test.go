@@ -0,0 +1,256 @@
+package hibp_test
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/breachchecker/hibp"
+)
+
+// sha1Hex returns the uppercase SHA-1 of password as Have I Been Pwned writes it.
+func sha1Hex(password string) string {
+	digest := sha1.Sum([]byte(password)) //nolint:gosec
+
+	return strings.ToUpper(hex.EncodeToString(digest[:]))
+}
+
+func breachedPasswords() []string {
+	passwords := []string{"password", "123456", "Correct-Horse-9!"}
+	for i := range 200 {
+		passwords = append(passwords, fmt.Sprintf("password-%d", i))
+	}
+
+	return passwords
+}
+
+// writeRanges writes passwords as range files named by the 5 character prefix, as the range API returns them.
+func writeRanges(t *testing.T, passwords []string, transform func(string) string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	ranges := map[string][]string{}
+
+	for _, password := range passwords {
+		hash := sha1Hex(password)
+		ranges[hash[:5]] = append(ranges[hash[:5]], transform(hash[5:])+":3\r\n")
+	}
+
+	for prefix, lines := range ranges {
+		err := os.WriteFile(filepath.Join(dir, prefix+".txt"), []byte(strings.Join(lines, "")), 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+// writeOrdered writes passwords to a single file ordered by hash, as the ordered download is.
+func writeOrdered(t *testing.T, passwords []string) string {
+	t.Helper()
+
+	hashes := make([]string, 0, len(passwords))
+	for _, password := range passwords {
+		hashes = append(hashes, sha1Hex(password))
+	}
+
+	slices.Sort(hashes)
+
+	var builder strings.Builder
+	for i, hash := range hashes {
+		fmt.Fprintf(&builder, "%s:%d\r\n", hash, i+1)
+	}
+
+	path := filepath.Join(t.TempDir(), "pwned-passwords-sha1-ordered-by-hash.txt")
+
+	err := os.WriteFile(path, []byte(builder.String()), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestBreached(t *testing.T) {
+	t.Parallel()
+
+	passwords := breachedPasswords()
+
+	tests := []struct {
+		name string
+		path func(t *testing.T) string
+	}{
+		{name: "range files", path: func(t *testing.T) string {
+			t.Helper()
+
+			return writeRanges(t, passwords, strings.ToUpper)
+		}},
+		{name: "lowercase range files", path: func(t *testing.T) string {
+			t.Helper()
+
+			return writeRanges(t, passwords, strings.ToLower)
+		}},
+		{name: "ordered file", path: func(t *testing.T) string {
+			t.Helper()
+
+			return writeOrdered(t, passwords)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			checker, err := hibp.NewChecker(tt.path(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, password := range passwords {
+				breached, err := checker.Breached(t.Context(), password)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !breached {
+					t.Fatalf("%q is not breached", password)
+				}
+			}
+
+			for _, password := range []string{"", "Password", "password-200", "a much longer passphrase"} {
+				breached, err := checker.Breached(t.Context(), password)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if breached {
+					t.Fatalf("%q is breached", password)
+				}
+			}
+		})
+	}
+}
+
+func TestBreachedMatchesTheSuffixOnly(t *testing.T) {
+	t.Parallel()
+
+	// a line with the full hash, or with the suffix of another prefix, must not match
+	hash := sha1Hex("password")
+	dir := t.TempDir()
+	lines := hash + ":1\r\n" + hash[5:len(hash)-1] + "0:1\r\n"
+
+	err := os.WriteFile(filepath.Join(dir, hash[:5]+".txt"), []byte(lines), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other := sha1Hex("123456")
+
+	err = os.WriteFile(filepath.Join(dir, other[:5]+".txt"), []byte(hash[5:]+":1\r\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checker, err := hibp.NewChecker(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, password := range []string{"password", "123456"} {
+		breached, err := checker.Breached(t.Context(), password)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if breached {
+			t.Fatalf("%q is breached", password)
+		}
+	}
+}
+
+func TestBreachedCanceled(t *testing.T) {
+	t.Parallel()
+
+	checker, err := hibp.NewChecker(writeRanges(t, breachedPasswords(), strings.ToUpper))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err = checker.Breached(ctx, "password")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestNewCheckerMissingPath(t *testing.T) {
+	t.Parallel()
+
+	_, err := hibp.NewChecker(filepath.Join(t.TempDir(), "missing"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("got %v, want %v", err, os.ErrNotExist)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	passwords := breachedPasswords()
+
+	want := make([]string, 0, len(passwords))
+	for _, password := range passwords {
+		want = append(want, sha1Hex(password))
+	}
+
+	slices.Sort(want)
+
+	paths := map[string]string{
+		"range files":  writeRanges(t, passwords, strings.ToLower),
+		"ordered file": writeOrdered(t, passwords),
+	}
+
+	for name, path := range paths {
+		var got []string
+
+		err := hibp.Walk(path, func(digest [sha1.Size]byte) error {
+			got = append(got, strings.ToUpper(hex.EncodeToString(digest[:])))
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		slices.Sort(got)
+
+		if !slices.Equal(got, want) {
+			t.Fatalf("%s: got %d hashes, want %d", name, len(got), len(want))
+		}
+	}
+}
+
+func TestWalkInvalidHash(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "hashes.txt")
+
+	err := os.WriteFile(path, []byte("not a hash:1\r\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = hibp.Walk(path, func([sha1.Size]byte) error { return nil })
+	if !errors.Is(err, hibp.ErrInvalidHash) {
+		t.Fatalf("got %v, want %v", err, hibp.ErrInvalidHash)
+	}
+}
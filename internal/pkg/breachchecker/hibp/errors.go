@@ -0,0 +1,5 @@
+package hibp
+
+import "errors"
+
+var ErrInvalidHash = errors.New("hash must be 40 hex characters of SHA-1")
@@ -0,0 +1,77 @@
+package hibp
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Walk calls fn with every hash of a download in either format, e.g. to build a Bloom filter from it.
+func Walk(path string, fn func(digest [sha1.Size]byte) error) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat breached passwords: %w", err)
+	}
+
+	if !info.IsDir() {
+		return walkFile(path, "", fn)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("failed to read breached passwords: %w", err)
+	}
+
+	for _, entry := range entries {
+		prefix, ok := strings.CutSuffix(entry.Name(), ".txt")
+		if entry.IsDir() || !ok || len(prefix) != prefixLength {
+			continue
+		}
+
+		err := walkFile(filepath.Join(path, entry.Name()), prefix, fn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkFile(path string, prefix string, fn func(digest [sha1.Size]byte) error) error {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("failed to open breached passwords: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		hash, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if hash == "" {
+			continue
+		}
+
+		var digest [sha1.Size]byte
+
+		n, err := hex.Decode(digest[:], []byte(prefix+hash))
+		if err != nil || n != sha1.Size {
+			return fmt.Errorf("hash %q in %s: %w", prefix+hash, path, ErrInvalidHash)
+		}
+
+		err = fn(digest)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return fmt.Errorf("failed to read breached passwords: %w", err)
+	}
+
+	return nil
+}
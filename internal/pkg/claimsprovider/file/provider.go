@@ -0,0 +1,41 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/claimsprovider"
+)
+
+var _ claimsprovider.Provider = (*Provider)(nil)
+
+// Provider reads custom claims from a JSON file mapping usernames to their claims, e.g.
+//
+//	{"alice": {"roles": ["admin"]}}
+type Provider struct {
+	claims map[string]map[string]any
+}
+
+func NewProvider(path string) (*Provider, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read claims file: %w", err)
+	}
+
+	var claims map[string]map[string]any
+
+	err = json.Unmarshal(data, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode claims file: %w", err)
+	}
+
+	return &Provider{claims: claims}, nil
+}
+
+func (p *Provider) GetClaims(ctx context.Context, username string) (map[string]any, error) {
+	return maps.Clone(p.claims[username]), nil
+}
@@ -0,0 +1,8 @@
+package claimsprovider
+
+import "context"
+
+// Provider supplies the custom claims put into the access tokens of a user.
+type Provider interface {
+	GetClaims(ctx context.Context, username string) (map[string]any, error)
+}
@@ -0,0 +1,8 @@
+package clientrepository
+
+import "errors"
+
+var (
+	ErrClientNotFound      = errors.New("client not found")
+	ErrClientAlreadyExists = errors.New("client already exists")
+)
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/clientrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+var _ clientrepository.Repository = (*Repository)(nil)
+
+type Repository struct {
+	mu      sync.RWMutex
+	clients map[string]*domain.Client
+}
+
+func NewRepository() *Repository {
+	return &Repository{
+		mu:      sync.RWMutex{},
+		clients: make(map[string]*domain.Client),
+	}
+}
+
+func (r *Repository) CreateClient(ctx context.Context, client *domain.Client) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.clients[client.ID()]; ok {
+		return clientrepository.ErrClientAlreadyExists
+	}
+
+	r.clients[client.ID()] = client
+
+	return nil
+}
+
+func (r *Repository) GetClient(ctx context.Context, id string) (*domain.Client, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	client, ok := r.clients[id]
+	if !ok {
+		return nil, clientrepository.ErrClientNotFound
+	}
+
+	return client, nil
+}
@@ -0,0 +1,12 @@
+package clientrepository
+
+import (
+	"context"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+type Repository interface {
+	CreateClient(ctx context.Context, client *domain.Client) error
+	GetClient(ctx context.Context, id string) (*domain.Client, error)
+}
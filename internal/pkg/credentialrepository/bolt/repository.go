@@ -0,0 +1,312 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ credentialrepository.Repository = (*Repository)(nil)
+
+var bucketCredentials = []byte("credentials") //nolint:gochecknoglobals
+
+// Repository stores credentials in the "credentials" bucket of a shared bbolt file,
+// keyed by username. Values are JSON records, so user metadata can be added without a new bucket.
+type Repository struct {
+	db *bolt.DB
+}
+
+type record struct {
+	Password         string            `json:"password"`
+	Email            string            `json:"email,omitempty"`
+	EmailVerified    bool              `json:"email_verified,omitempty"`
+	TokensValidAfter time.Time         `json:"tokens_valid_after,omitzero"`
+	Disabled         bool              `json:"disabled,omitempty"`
+	FailedLogins     int               `json:"failed_logins,omitempty"`
+	LockedUntil      time.Time         `json:"locked_until,omitzero"`
+	DisplayName      string            `json:"display_name,omitempty"`
+	Locale           string            `json:"locale,omitempty"`
+	Attributes       map[string]string `json:"attributes,omitempty"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+}
+
+// NewRepository uses db, usually opened by boltdb.Open. The caller keeps ownership of db.
+func NewRepository(db *bolt.DB) (*Repository, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketCredentials)
+
+		return err //nolint:wrapcheck
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create credentials bucket: %w", err)
+	}
+
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) CreateCredential(ctx context.Context, credential *domain.Credential) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	now := time.Now()
+
+	rec := &record{CreatedAt: now, UpdatedAt: now} //nolint:exhaustruct
+	rec.setCredential(credential)
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode credential: %w", err)
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		bucket := tx.Bucket(bucketCredentials)
+		key := []byte(credential.Username())
+
+		if bucket.Get(key) != nil {
+			return credentialrepository.ErrCredentialAlreadyExists
+		}
+
+		err := bucket.Put(key, data)
+		if err != nil {
+			return fmt.Errorf("failed to create credential: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) DeleteCredential(ctx context.Context, credential *domain.Credential) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		bucket := tx.Bucket(bucketCredentials)
+		key := []byte(credential.Username())
+
+		if bucket.Get(key) == nil {
+			return credentialrepository.ErrCredentialNotFound
+		}
+
+		err := bucket.Delete(key)
+		if err != nil {
+			return fmt.Errorf("failed to delete credential: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) CompareAndUpdateCredential(ctx context.Context, old, credential *domain.Credential) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.update(credential.Username(), func(rec *record) error {
+		if !rec.credential(credential.Username()).Equal(old) {
+			return credentialrepository.ErrCredentialChanged
+		}
+
+		rec.setCredential(credential)
+
+		return nil
+	})
+}
+
+func (r *Repository) GetCredential(ctx context.Context, username string) (*domain.Credential, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	rec, err := r.read(username)
+	if err != nil {
+		return nil, err
+	}
+
+	return rec.credential(username), nil
+}
+
+// ListCredentials walks the bucket from after, bbolt keeps keys in byte order.
+func (r *Repository) ListCredentials(
+	ctx context.Context,
+	after string,
+	search string,
+	limit int,
+) ([]*domain.Credential, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var credentials []*domain.Credential
+
+	err = r.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(bucketCredentials).Cursor()
+
+		for key, data := cursor.Seek([]byte(after)); key != nil && len(credentials) < limit; key, data = cursor.Next() {
+			if bytes.Equal(key, []byte(after)) {
+				continue
+			}
+
+			var rec record
+
+			err := json.Unmarshal(data, &rec)
+			if err != nil {
+				return fmt.Errorf("failed to decode credential: %w", err)
+			}
+
+			credential := rec.credential(string(key))
+			if strings.Contains(credential.Username(), search) || strings.Contains(credential.Email(), search) {
+				credentials = append(credentials, credential)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return credentials, nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, username string) (*domain.User, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	rec, err := r.read(username)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewUser(username, rec.CreatedAt, rec.UpdatedAt).
+		WithDisplayName(rec.DisplayName).
+		WithLocale(rec.Locale).
+		WithAttributes(rec.Attributes), nil
+}
+
+func (r *Repository) UpdateUser(ctx context.Context, user *domain.User) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.update(user.Username(), func(rec *record) error {
+		rec.DisplayName = user.DisplayName()
+		rec.Locale = user.Locale()
+		rec.Attributes = user.Attributes()
+		rec.UpdatedAt = time.Now()
+
+		return nil
+	})
+}
+
+func (r *Repository) TouchUser(ctx context.Context, username string) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.update(username, func(rec *record) error {
+		rec.UpdatedAt = time.Now()
+
+		return nil
+	})
+}
+
+func (r *Repository) read(username string) (*record, error) {
+	var rec record
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketCredentials).Get([]byte(username))
+		if data == nil {
+			return credentialrepository.ErrCredentialNotFound
+		}
+
+		// data is only valid during the transaction, decoding copies it
+		err := json.Unmarshal(data, &rec)
+		if err != nil {
+			return fmt.Errorf("failed to decode credential: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return &rec, nil
+}
+
+// update applies change to the record of an existing user and stores it, in one transaction.
+// A failing change stores nothing.
+func (r *Repository) update(username string, change func(rec *record) error) error {
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		bucket := tx.Bucket(bucketCredentials)
+		key := []byte(username)
+
+		data := bucket.Get(key)
+		if data == nil {
+			return credentialrepository.ErrCredentialNotFound
+		}
+
+		var rec record
+
+		err := json.Unmarshal(data, &rec)
+		if err != nil {
+			return fmt.Errorf("failed to decode credential: %w", err)
+		}
+
+		err = change(&rec)
+		if err != nil {
+			return err
+		}
+
+		data, err = json.Marshal(&rec)
+		if err != nil {
+			return fmt.Errorf("failed to encode credential: %w", err)
+		}
+
+		err = bucket.Put(key, data)
+		if err != nil {
+			return fmt.Errorf("failed to update credential: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (rec *record) setCredential(credential *domain.Credential) {
+	rec.Password = credential.Password()
+	rec.Email = credential.Email()
+	rec.EmailVerified = credential.EmailVerified()
+	rec.TokensValidAfter = credential.TokensValidAfter()
+	rec.Disabled = credential.Disabled()
+	rec.FailedLogins = credential.FailedLogins()
+	rec.LockedUntil = credential.LockedUntil()
+}
+
+func (rec *record) credential(username string) *domain.Credential {
+	return domain.NewCredential(username, rec.Password).
+		WithEmail(rec.Email).
+		WithEmailVerified(rec.EmailVerified).
+		WithTokensValidAfter(rec.TokensValidAfter).
+		WithDisabled(rec.Disabled).
+		WithFailedLogins(rec.FailedLogins).
+		WithLockedUntil(rec.LockedUntil)
+}
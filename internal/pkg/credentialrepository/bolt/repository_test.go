@@ -0,0 +1,43 @@
+package bolt_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/boltdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/bolt"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, newRepository)
+}
+
+func TestStress(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.RunStress(t, newRepository)
+}
+
+func newRepository(t *testing.T) credentialrepository.Repository {
+	t.Helper()
+
+	db, err := boltdb.Open(filepath.Join(t.TempDir(), "key-stone.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	repo, err := bolt.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return repo
+}
@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrCredentialNotFound      = errors.New("credential not found")
 	ErrCredentialAlreadyExists = errors.New("credential already exists")
+	ErrCredentialChanged       = errors.New("credential changed")
 )
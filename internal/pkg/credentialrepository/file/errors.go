@@ -0,0 +1,5 @@
+package file
+
+import "errors"
+
+var ErrLegacyCollision = errors.New("legacy credentials collide after normalization")
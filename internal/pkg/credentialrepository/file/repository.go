@@ -2,38 +2,97 @@ package file
 
 import (
 	"context"
+	"encoding/base32"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/neatflowcv/key-stone/internal/pkg/atomicfile"
 	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
 	"github.com/neatflowcv/key-stone/internal/pkg/domain"
 )
 
 var _ credentialrepository.Repository = (*Repository)(nil)
 
+// usersDir holds one file per user, named after the encoded username.
+const usersDir = "users"
+
+// encoding turns usernames into file names. Lowercase base32hex only uses [0-9a-v], so a name can
+// neither escape the directory nor collide with another one on case-insensitive file systems.
+var encoding = base32.HexEncoding.WithPadding(base32.NoPadding) //nolint:gochecknoglobals
+
+// record is the content of a credential file, with the profile of the user.
+// Files of older versions hold nothing but the hash.
+type record struct {
+	Password         string            `json:"password"`
+	Email            string            `json:"email,omitempty"`
+	EmailVerified    bool              `json:"email_verified,omitempty"`
+	TokensValidAfter time.Time         `json:"tokens_valid_after,omitzero"`
+	Disabled         bool              `json:"disabled,omitempty"`
+	FailedLogins     int               `json:"failed_logins,omitempty"`
+	LockedUntil      time.Time         `json:"locked_until,omitzero"`
+	DisplayName      string            `json:"display_name,omitempty"`
+	Locale           string            `json:"locale,omitempty"`
+	Attributes       map[string]string `json:"attributes,omitempty"`
+	CreatedAt        time.Time         `json:"created_at,omitzero"`
+	UpdatedAt        time.Time         `json:"updated_at,omitzero"`
+}
+
 type Repository struct {
+	// mu keeps an update from recreating a credential that is deleted at the same time
+	mu   sync.Mutex
 	path string
 }
 
+// NewRepository stores credentials under path.
+// Credentials of older versions, stored in files named after the raw username, are moved into place.
 func NewRepository(path string) (*Repository, error) {
 	const createPerm = 0750
 
-	err := os.MkdirAll(path, createPerm)
+	err := os.MkdirAll(filepath.Join(path, usersDir), createPerm)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create directory: %w", err)
 	}
 
-	return &Repository{path: path}, nil
+	repo := &Repository{mu: sync.Mutex{}, path: path}
+
+	err = repo.migrateLegacy()
+	if err != nil {
+		return nil, err
+	}
+
+	return repo, nil
 }
 
+// CreateCredential writes the credential through a synced temporary file,
+// so a crash never leaves a half-written hash behind.
 func (r *Repository) CreateCredential(ctx context.Context, credential *domain.Credential) error {
-	const openPerm = 0600
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
 
-	filePath := filepath.Join(r.path, credential.Username())
+	now := time.Now()
 
-	file, err := os.OpenFile(filepath.Clean(filePath), os.O_CREATE|os.O_WRONLY|os.O_EXCL, openPerm)
+	rec := &record{CreatedAt: now, UpdatedAt: now} //nolint:exhaustruct
+	rec.setCredential(credential)
+
+	data, err := encode(rec)
+	if err != nil {
+		return err
+	}
+
+	err = atomicfile.Create(r.filePath(credential.Username()), data)
 	if err != nil {
 		if os.IsExist(err) {
 			return credentialrepository.ErrCredentialAlreadyExists
@@ -42,25 +101,19 @@ func (r *Repository) CreateCredential(ctx context.Context, credential *domain.Cr
 		return fmt.Errorf("failed to create credential: %w", err)
 	}
 
-	defer func() {
-		err := file.Close()
-		if err != nil {
-			log.Printf("failed to close file: %v", err)
-		}
-	}()
-
-	_, err = file.WriteString(credential.Password())
-	if err != nil {
-		return fmt.Errorf("failed to write credential: %w", err)
-	}
-
 	return nil
 }
 
 func (r *Repository) DeleteCredential(ctx context.Context, credential *domain.Credential) error {
-	filePath := filepath.Join(r.path, credential.Username())
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
-	err := os.Remove(filePath)
+	err = os.Remove(r.filePath(credential.Username()))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return credentialrepository.ErrCredentialNotFound
@@ -72,10 +125,150 @@ func (r *Repository) DeleteCredential(ctx context.Context, credential *domain.Cr
 	return nil
 }
 
+// CompareAndUpdateCredential replaces an existing credential through a synced temporary file and keeps the profile.
+func (r *Repository) CompareAndUpdateCredential(ctx context.Context, old, credential *domain.Credential) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.update(credential.Username(), func(rec *record) error {
+		if !rec.credential(credential.Username()).Equal(old) {
+			return credentialrepository.ErrCredentialChanged
+		}
+
+		rec.setCredential(credential)
+
+		return nil
+	})
+}
+
 func (r *Repository) GetCredential(ctx context.Context, username string) (*domain.Credential, error) {
-	filePath := filepath.Join(r.path, username)
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	rec, err := r.read(username)
+	if err != nil {
+		return nil, err
+	}
+
+	return rec.credential(username), nil
+}
+
+// ListCredentials reads every file name, so it takes longer the more users there are.
+func (r *Repository) ListCredentials(
+	ctx context.Context,
+	after string,
+	search string,
+	limit int,
+) ([]*domain.Credential, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	entries, err := os.ReadDir(filepath.Join(r.path, usersDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	var usernames []string
+
+	for _, entry := range entries {
+		// temporary files of atomicfile start with a dot
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		username, err := encoding.DecodeString(strings.ToUpper(entry.Name()))
+		if err != nil {
+			continue
+		}
+
+		if string(username) > after {
+			usernames = append(usernames, string(username))
+		}
+	}
+
+	slices.Sort(usernames)
+
+	var credentials []*domain.Credential
+
+	for _, username := range usernames {
+		if len(credentials) == limit {
+			break
+		}
+
+		rec, err := r.read(username)
+		if err != nil {
+			// deleted since the directory was read
+			if errors.Is(err, credentialrepository.ErrCredentialNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		credential := rec.credential(username)
+		if strings.Contains(credential.Username(), search) || strings.Contains(credential.Email(), search) {
+			credentials = append(credentials, credential)
+		}
+	}
+
+	return credentials, nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, username string) (*domain.User, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	rec, err := r.read(username)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewUser(username, rec.CreatedAt, rec.UpdatedAt).
+		WithDisplayName(rec.DisplayName).
+		WithLocale(rec.Locale).
+		WithAttributes(rec.Attributes), nil
+}
+
+// UpdateUser replaces the profile through a synced temporary file and keeps the credential.
+func (r *Repository) UpdateUser(ctx context.Context, user *domain.User) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.update(user.Username(), func(rec *record) error {
+		rec.DisplayName = user.DisplayName()
+		rec.Locale = user.Locale()
+		rec.Attributes = user.Attributes()
+		rec.UpdatedAt = time.Now()
+
+		return nil
+	})
+}
 
-	password, err := os.ReadFile(filepath.Clean(filePath))
+func (r *Repository) TouchUser(ctx context.Context, username string) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.update(username, func(rec *record) error {
+		rec.UpdatedAt = time.Now()
+
+		return nil
+	})
+}
+
+func (r *Repository) read(username string) (*record, error) {
+	data, err := os.ReadFile(r.filePath(username))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, credentialrepository.ErrCredentialNotFound
@@ -84,5 +277,164 @@ func (r *Repository) GetCredential(ctx context.Context, username string) (*domai
 		return nil, fmt.Errorf("failed to read credential: %w", err)
 	}
 
-	return domain.NewCredential(username, string(password)), nil
+	return decode(data)
+}
+
+// update applies change to the record of an existing user and stores it.
+// A failing change stores nothing.
+func (r *Repository) update(username string, change func(rec *record) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec, err := r.read(username)
+	if err != nil {
+		return err
+	}
+
+	err = change(rec)
+	if err != nil {
+		return err
+	}
+
+	data, err := encode(rec)
+	if err != nil {
+		return err
+	}
+
+	err = atomicfile.Write(r.filePath(username), data)
+	if err != nil {
+		return fmt.Errorf("failed to update credential: %w", err)
+	}
+
+	return nil
+}
+
+func (rec *record) credential(username string) *domain.Credential {
+	return domain.NewCredential(username, rec.Password).
+		WithEmail(rec.Email).
+		WithEmailVerified(rec.EmailVerified).
+		WithTokensValidAfter(rec.TokensValidAfter).
+		WithDisabled(rec.Disabled).
+		WithFailedLogins(rec.FailedLogins).
+		WithLockedUntil(rec.LockedUntil)
+}
+
+func (rec *record) setCredential(credential *domain.Credential) {
+	rec.Password = credential.Password()
+	rec.Email = credential.Email()
+	rec.EmailVerified = credential.EmailVerified()
+	rec.TokensValidAfter = credential.TokensValidAfter()
+	rec.Disabled = credential.Disabled()
+	rec.FailedLogins = credential.FailedLogins()
+	rec.LockedUntil = credential.LockedUntil()
+}
+
+func encode(rec *record) ([]byte, error) {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode credential: %w", err)
+	}
+
+	return data, nil
+}
+
+// decode reads a record, or the bare hash of an older version. A hash is never a JSON object.
+func decode(data []byte) (*record, error) {
+	if len(data) == 0 || data[0] != '{' || !json.Valid(data) {
+		return &record{Password: string(data)}, nil //nolint:exhaustruct
+	}
+
+	var rec record
+
+	err := json.Unmarshal(data, &rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode credential: %w", err)
+	}
+
+	return &rec, nil
+}
+
+func (r *Repository) filePath(username string) string {
+	return filepath.Join(r.path, usersDir, strings.ToLower(encoding.EncodeToString([]byte(username))))
+}
+
+// migrateLegacy moves credentials stored in files named after the raw username into usersDir,
+// under the normalized username. Files that are no valid username or hold no password hash,
+// e.g. the database of another repository, are left alone. Two files of one normalized username
+// fail the migration, which of them is the user is for an administrator to decide.
+func (r *Repository) migrateLegacy() error {
+	entries, err := os.ReadDir(r.path)
+	if err != nil {
+		return fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	// reserved usernames are only refused at registration, an existing user keeps the name
+	usernames := domain.NewUsernamePolicy().WithReserved()
+	legacy := make(map[string]string)
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		username, err := usernames.Validate(name)
+		if err != nil {
+			log.Printf("skipping %q, it is no legacy credential: %v", name, err)
+
+			continue
+		}
+
+		ok, err := r.isLegacyCredential(name)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			log.Printf("skipping %q, it is no legacy credential: it holds no password hash", name)
+
+			continue
+		}
+
+		if other, ok := legacy[username]; ok {
+			return fmt.Errorf("%q and %q are both %q: %w", other, name, username, ErrLegacyCollision)
+		}
+
+		legacy[username] = name
+	}
+
+	for _, username := range slices.Sorted(maps.Keys(legacy)) {
+		name := legacy[username]
+		target := r.filePath(username)
+
+		_, err := os.Stat(target)
+		if err == nil {
+			log.Printf("credential of %q exists in both layouts, keeping the legacy file %q", username, name)
+
+			continue
+		}
+
+		err = os.Rename(filepath.Join(r.path, name), target)
+		if err != nil {
+			return fmt.Errorf("failed to migrate credential of %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// isLegacyCredential tells whether the file holds a record or the bare password hash of an older version.
+func (r *Repository) isLegacyCredential(name string) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(r.path, name))
+	if err != nil {
+		return false, fmt.Errorf("failed to read credential of %q: %w", name, err)
+	}
+
+	rec, err := decode(data)
+	if err != nil {
+		return false, nil //nolint:nilerr
+	}
+
+	return rec.Password != "" && utf8.ValidString(rec.Password) &&
+		!strings.ContainsFunc(rec.Password, unicode.IsControl), nil
 }
@@ -0,0 +1,104 @@
+package file_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/file"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, newRepository)
+}
+
+func TestStress(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.RunStress(t, newRepository)
+}
+
+func TestMigrateLegacy(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	legacy := map[string]string{
+		"Alice":          "$2a$10$hash",
+		"credentials.db": "SQLite format 3\x00",
+		"not a user!":    "$2a$10$hash",
+	}
+
+	for name, content := range legacy {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	repo, err := file.NewRepository(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the user is found under the normalized name
+	credential, err := repo.GetCredential(t.Context(), "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if credential.Password() != legacy["Alice"] {
+		t.Fatalf("got password %q, want %q", credential.Password(), legacy["Alice"])
+	}
+
+	// everything else stays where it is
+	for _, name := range []string{"credentials.db", "not a user!"} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMigrateLegacyCollision(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	// full width letters, a case-insensitive file system would not even store "Bob" and "bob"
+	names := []string{"bob", "ｂｏｂ"}
+
+	for _, name := range names {
+		err := os.WriteFile(filepath.Join(dir, name), []byte("$2a$10$"+name), 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := file.NewRepository(dir)
+	if !errors.Is(err, file.ErrLegacyCollision) {
+		t.Fatalf("got %v, want %v", err, file.ErrLegacyCollision)
+	}
+
+	// neither file is moved
+	for _, name := range names {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func newRepository(t *testing.T) credentialrepository.Repository {
+	t.Helper()
+
+	repo, err := file.NewRepository(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return repo
+}
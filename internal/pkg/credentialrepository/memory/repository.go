@@ -2,6 +2,11 @@ package memory
 
 import (
 	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
 	"github.com/neatflowcv/key-stone/internal/pkg/domain"
@@ -10,39 +15,192 @@ import (
 var _ credentialrepository.Repository = (*Repository)(nil)
 
 type Repository struct {
+	mu          sync.RWMutex
 	credentials map[string]*domain.Credential
+	users       map[string]*domain.User
 }
 
 func NewRepository() *Repository {
 	return &Repository{
+		mu:          sync.RWMutex{},
 		credentials: make(map[string]*domain.Credential),
+		users:       make(map[string]*domain.User),
 	}
 }
 
 func (r *Repository) CreateCredential(ctx context.Context, credential *domain.Credential) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	if _, ok := r.credentials[credential.Username()]; ok {
 		return credentialrepository.ErrCredentialAlreadyExists
 	}
 
+	now := time.Now()
+
 	r.credentials[credential.Username()] = credential
+	r.users[credential.Username()] = domain.NewUser(credential.Username(), now, now)
 
 	return nil
 }
 
 func (r *Repository) DeleteCredential(ctx context.Context, credential *domain.Credential) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	if _, ok := r.credentials[credential.Username()]; !ok {
 		return credentialrepository.ErrCredentialNotFound
 	}
 
 	delete(r.credentials, credential.Username())
+	delete(r.users, credential.Username())
+
+	return nil
+}
+
+func (r *Repository) CompareAndUpdateCredential(ctx context.Context, old, credential *domain.Credential) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.credentials[credential.Username()]
+	if !ok {
+		return credentialrepository.ErrCredentialNotFound
+	}
+
+	if !stored.Equal(old) {
+		return credentialrepository.ErrCredentialChanged
+	}
+
+	r.credentials[credential.Username()] = credential
 
 	return nil
 }
 
 func (r *Repository) GetCredential(ctx context.Context, username string) (*domain.Credential, error) {
-	if _, ok := r.credentials[username]; !ok {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	credential, ok := r.credentials[username]
+	if !ok {
+		return nil, credentialrepository.ErrCredentialNotFound
+	}
+
+	return credential, nil
+}
+
+func (r *Repository) ListCredentials(
+	ctx context.Context,
+	after string,
+	search string,
+	limit int,
+) ([]*domain.Credential, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var credentials []*domain.Credential
+
+	for _, username := range slices.Sorted(maps.Keys(r.credentials)) {
+		if len(credentials) == limit {
+			break
+		}
+
+		credential := r.credentials[username]
+		if username > after && matches(credential, search) {
+			credentials = append(credentials, credential)
+		}
+	}
+
+	return credentials, nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, username string) (*domain.User, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[username]
+	if !ok {
 		return nil, credentialrepository.ErrCredentialNotFound
 	}
 
-	return r.credentials[username], nil
+	return user, nil
+}
+
+func (r *Repository) UpdateUser(ctx context.Context, user *domain.User) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.users[user.Username()]
+	if !ok {
+		return credentialrepository.ErrCredentialNotFound
+	}
+
+	r.users[user.Username()] = updated(user, stored.CreatedAt())
+
+	return nil
+}
+
+func (r *Repository) TouchUser(ctx context.Context, username string) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.users[username]
+	if !ok {
+		return credentialrepository.ErrCredentialNotFound
+	}
+
+	r.users[username] = updated(stored, stored.CreatedAt())
+
+	return nil
+}
+
+// updated copies the profile fields of user into a user updated now.
+func updated(user *domain.User, createdAt time.Time) *domain.User {
+	return domain.NewUser(user.Username(), createdAt, time.Now()).
+		WithDisplayName(user.DisplayName()).
+		WithLocale(user.Locale()).
+		WithAttributes(user.Attributes())
+}
+
+func matches(credential *domain.Credential, search string) bool {
+	return strings.Contains(credential.Username(), search) || strings.Contains(credential.Email(), search)
 }
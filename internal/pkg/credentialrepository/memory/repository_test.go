@@ -0,0 +1,25 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/memory"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, newRepository)
+}
+
+func TestStress(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.RunStress(t, newRepository)
+}
+
+func newRepository(_ *testing.T) credentialrepository.Repository {
+	return memory.NewRepository()
+}
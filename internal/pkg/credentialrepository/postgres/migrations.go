@@ -0,0 +1,25 @@
+package postgres
+
+// migrationsTable records the applied migrations. It predates the other postgres repositories,
+// so it keeps the generic name.
+const migrationsTable = "schema_migrations"
+
+// migrations are applied in order by postgresdb.Migrate.
+// Never edit a released migration, append a new one instead.
+var migrations = []string{ //nolint:gochecknoglobals
+	`CREATE TABLE credentials (
+		username   TEXT PRIMARY KEY,
+		password   TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`,
+	`ALTER TABLE credentials ADD COLUMN tokens_valid_after TIMESTAMPTZ`,
+	`ALTER TABLE credentials ADD COLUMN email TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE credentials ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE`,
+	`ALTER TABLE credentials ADD COLUMN display_name TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE credentials ADD COLUMN locale TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE credentials ADD COLUMN attributes JSONB NOT NULL DEFAULT '{}'`,
+	`ALTER TABLE credentials ADD COLUMN disabled BOOLEAN NOT NULL DEFAULT FALSE`,
+	`ALTER TABLE credentials ADD COLUMN failed_logins INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE credentials ADD COLUMN locked_until TIMESTAMPTZ`,
+}
@@ -0,0 +1,266 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+)
+
+var _ credentialrepository.Repository = (*Repository)(nil)
+
+// Repository stores credentials in PostgreSQL, so several replicas can share them.
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRepository stores credentials in the database of pool and migrates their table.
+// The pool stays open, it belongs to the caller, see postgresdb.Open.
+func NewRepository(ctx context.Context, pool *pgxpool.Pool) (*Repository, error) {
+	err := postgresdb.Migrate(ctx, pool, migrationsTable, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate credentials: %w", err)
+	}
+
+	return &Repository{pool: pool}, nil
+}
+
+// credentialColumns are read by scanCredential, in its order.
+const credentialColumns = "username, password, email, email_verified, tokens_valid_after, " +
+	"disabled, failed_logins, locked_until"
+
+func (r *Repository) CreateCredential(ctx context.Context, credential *domain.Credential) error {
+	_, err := r.pool.Exec(ctx,
+		"INSERT INTO credentials (username, password, email, email_verified, tokens_valid_after, "+
+			"disabled, failed_logins, locked_until) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		credential.Username(), credential.Password(), credential.Email(), credential.EmailVerified(),
+		nullableTime(credential.TokensValidAfter()), credential.Disabled(), credential.FailedLogins(),
+		nullableTime(credential.LockedUntil()),
+	)
+	if err != nil {
+		if postgresdb.IsUniqueViolation(err) {
+			return credentialrepository.ErrCredentialAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create credential: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteCredential(ctx context.Context, credential *domain.Credential) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM credentials WHERE username = $1", credential.Username())
+	if err != nil {
+		return fmt.Errorf("failed to delete credential: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return credentialrepository.ErrCredentialNotFound
+	}
+
+	return nil
+}
+
+// CompareAndUpdateCredential compares the times with IS NOT DISTINCT FROM, which treats two NULLs as equal.
+func (r *Repository) CompareAndUpdateCredential(ctx context.Context, old, credential *domain.Credential) error {
+	tag, err := r.pool.Exec(ctx,
+		"UPDATE credentials SET password = $1, email = $2, email_verified = $3, tokens_valid_after = $4, "+
+			"disabled = $5, failed_logins = $6, locked_until = $7 "+
+			"WHERE username = $8 AND password = $9 AND email = $10 AND email_verified = $11 "+
+			"AND tokens_valid_after IS NOT DISTINCT FROM $12 AND disabled = $13 AND failed_logins = $14 "+
+			"AND locked_until IS NOT DISTINCT FROM $15",
+		credential.Password(), credential.Email(), credential.EmailVerified(), nullableTime(credential.TokensValidAfter()),
+		credential.Disabled(), credential.FailedLogins(), nullableTime(credential.LockedUntil()),
+		credential.Username(),
+		old.Password(), old.Email(), old.EmailVerified(), nullableTime(old.TokensValidAfter()),
+		old.Disabled(), old.FailedLogins(), nullableTime(old.LockedUntil()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update credential: %w", err)
+	}
+
+	if tag.RowsAffected() == 1 {
+		return nil
+	}
+
+	var exists bool
+
+	err = r.pool.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM credentials WHERE username = $1)", credential.Username(),
+	).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check credential: %w", err)
+	}
+
+	if !exists {
+		return credentialrepository.ErrCredentialNotFound
+	}
+
+	return credentialrepository.ErrCredentialChanged
+}
+
+func (r *Repository) GetCredential(ctx context.Context, username string) (*domain.Credential, error) {
+	credential, err := scanCredential(r.pool.QueryRow(ctx,
+		"SELECT "+credentialColumns+" FROM credentials WHERE username = $1", username,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, credentialrepository.ErrCredentialNotFound
+		}
+
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+// ListCredentials compares and orders usernames with the "C" collation, which is byte order,
+// whatever the collation of the database. The search uses strpos, so it needs no escaping like LIKE would.
+func (r *Repository) ListCredentials(
+	ctx context.Context,
+	after string,
+	search string,
+	limit int,
+) ([]*domain.Credential, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+credentialColumns+" FROM credentials "+
+			`WHERE username COLLATE "C" > $1 AND ($2 = '' OR strpos(username, $2) > 0 OR strpos(email, $2) > 0) `+
+			`ORDER BY username COLLATE "C" LIMIT $3`,
+		after, search, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	defer rows.Close()
+
+	var credentials []*domain.Credential
+
+	for rows.Next() {
+		credential, err := scanCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		credentials = append(credentials, credential)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	return credentials, nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, username string) (*domain.User, error) {
+	var (
+		displayName string
+		locale      string
+		attributes  map[string]string
+		createdAt   time.Time
+		updatedAt   time.Time
+	)
+
+	err := r.pool.QueryRow(ctx,
+		"SELECT display_name, locale, attributes, created_at, updated_at FROM credentials WHERE username = $1", username,
+	).Scan(&displayName, &locale, &attributes, &createdAt, &updatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, credentialrepository.ErrCredentialNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read user: %w", err)
+	}
+
+	return domain.NewUser(username, createdAt, updatedAt).
+		WithDisplayName(displayName).
+		WithLocale(locale).
+		WithAttributes(attributes), nil
+}
+
+func (r *Repository) UpdateUser(ctx context.Context, user *domain.User) error {
+	attributes := user.Attributes()
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	tag, err := r.pool.Exec(ctx,
+		"UPDATE credentials SET display_name = $1, locale = $2, attributes = $3, updated_at = now() "+
+			"WHERE username = $4",
+		user.DisplayName(), user.Locale(), attributes, user.Username(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return credentialrepository.ErrCredentialNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) TouchUser(ctx context.Context, username string) error {
+	tag, err := r.pool.Exec(ctx, "UPDATE credentials SET updated_at = now() WHERE username = $1", username)
+	if err != nil {
+		return fmt.Errorf("failed to touch user: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return credentialrepository.ErrCredentialNotFound
+	}
+
+	return nil
+}
+
+// scanCredential reads the credentialColumns of a pgx.Row or the current row of pgx.Rows.
+func scanCredential(row pgx.Row) (*domain.Credential, error) {
+	var (
+		username         string
+		password         string
+		email            string
+		emailVerified    bool
+		tokensValidAfter *time.Time
+		disabled         bool
+		failedLogins     int
+		lockedUntil      *time.Time
+	)
+
+	err := row.Scan(&username, &password, &email, &emailVerified, &tokensValidAfter,
+		&disabled, &failedLogins, &lockedUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credential: %w", err)
+	}
+
+	credential := domain.NewCredential(username, password).
+		WithEmail(email).
+		WithEmailVerified(emailVerified).
+		WithDisabled(disabled).
+		WithFailedLogins(failedLogins)
+
+	if tokensValidAfter != nil {
+		credential = credential.WithTokensValidAfter(*tokensValidAfter)
+	}
+
+	if lockedUntil != nil {
+		credential = credential.WithLockedUntil(*lockedUntil)
+	}
+
+	return credential, nil
+}
+
+// nullableTime stores the zero time as NULL.
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
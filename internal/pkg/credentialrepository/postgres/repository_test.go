@@ -0,0 +1,58 @@
+package postgres_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/postgres"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/repositorytest"
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+)
+
+// TestConformance needs a database in KS_REPOSITORY_DSN, e.g. the one make postgres starts.
+// The checks use random usernames, so they can run against the same database again.
+func TestConformance(t *testing.T) {
+	newRepository := repositoryFactory(t)
+
+	t.Parallel()
+
+	repositorytest.Run(t, newRepository)
+}
+
+// TestStress needs a database like TestConformance.
+func TestStress(t *testing.T) {
+	newRepository := repositoryFactory(t)
+
+	t.Parallel()
+
+	repositorytest.RunStress(t, newRepository)
+}
+
+// repositoryFactory skips the test without KS_REPOSITORY_DSN.
+func repositoryFactory(t *testing.T) func(t *testing.T) credentialrepository.Repository {
+	t.Helper()
+
+	dsn := os.Getenv("KS_REPOSITORY_DSN")
+	if dsn == "" {
+		t.Skip("KS_REPOSITORY_DSN is not set")
+	}
+
+	return func(t *testing.T) credentialrepository.Repository {
+		t.Helper()
+
+		pool, err := postgresdb.Open(t.Context(), dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Cleanup(pool.Close)
+
+		repo, err := postgres.NewRepository(t.Context(), pool)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return repo
+	}
+}
@@ -6,8 +6,29 @@ import (
 	"github.com/neatflowcv/key-stone/internal/pkg/domain"
 )
 
+// Repository stores the credentials of users, keyed by username. Usernames are compared byte by byte.
+// Every credential has a profile, a domain.User, created and deleted with it.
+// Implementations are safe for concurrent use and fail with the error of ctx, without side effects,
+// once ctx is done. repositorytest verifies this contract.
 type Repository interface {
 	CreateCredential(ctx context.Context, credential *domain.Credential) error
 	DeleteCredential(ctx context.Context, credential *domain.Credential) error
 	GetCredential(ctx context.Context, username string) (*domain.Credential, error)
+	// CompareAndUpdateCredential replaces the stored credential and keeps the profile, but only if the stored
+	// credential still equals old, as returned by GetCredential. Otherwise it stores nothing and returns
+	// ErrCredentialChanged, so a read, modify and write never overwrites a concurrent one.
+	// There is no unconditional update, every change of a credential is such a read, modify and write.
+	// The profile is not marked as updated, most writes are bookkeeping of logins, see TouchUser.
+	CompareAndUpdateCredential(ctx context.Context, old, credential *domain.Credential) error
+	// ListCredentials returns up to limit credentials with a username greater than after,
+	// ordered by the bytes of the username.
+	// A non-empty search only matches credentials whose username or email contains it, case-sensitively.
+	ListCredentials(ctx context.Context, after string, search string, limit int) ([]*domain.Credential, error)
+	// GetUser returns ErrCredentialNotFound if the user has no credential.
+	GetUser(ctx context.Context, username string) (*domain.User, error)
+	// UpdateUser stores the profile fields of user. The timestamps are kept by the repository, not taken from user.
+	UpdateUser(ctx context.Context, user *domain.User) error
+	// TouchUser marks the profile as updated now, after the user or an admin changed the credential,
+	// e.g. the password. Returns ErrCredentialNotFound if the user has no credential.
+	TouchUser(ctx context.Context, username string) error
 }
@@ -0,0 +1,614 @@
+package repositorytest
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+// Check verifies one part of the credentialrepository.Repository contract.
+// Checks use their own usernames, so they can share a repository.
+// The usernames start with a random prefix, so a persistent repository can be verified again.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context, repo credentialrepository.Repository) error
+}
+
+// Checks returns the whole contract every credentialrepository.Repository must fulfil.
+// Every call draws a new username prefix.
+func Checks() []Check {
+	const (
+		workers = 8
+		rounds  = 5
+	)
+
+	prefix := newPrefix()
+	prefixed := func(
+		run func(ctx context.Context, repo credentialrepository.Repository, prefix string) error,
+	) func(ctx context.Context, repo credentialrepository.Repository) error {
+		return func(ctx context.Context, repo credentialrepository.Repository) error {
+			return run(ctx, repo, prefix)
+		}
+	}
+
+	return []Check{
+		{Name: "create and get", Run: prefixed(checkCreateGet)},
+		{Name: "get missing", Run: prefixed(checkGetMissing)},
+		{Name: "create duplicate", Run: prefixed(checkCreateDuplicate)},
+		{Name: "delete", Run: prefixed(checkDelete)},
+		{Name: "delete missing", Run: prefixed(checkDeleteMissing)},
+		{Name: "update", Run: prefixed(checkUpdate)},
+		{Name: "compare and update", Run: prefixed(checkCompareAndUpdate)},
+		{Name: "compare and update missing", Run: prefixed(checkCompareAndUpdateMissing)},
+		{Name: "unicode username", Run: prefixed(checkUnicode)},
+		{Name: "list", Run: prefixed(checkList)},
+		{Name: "user profile", Run: prefixed(checkUser)},
+		{Name: "user missing", Run: prefixed(checkUserMissing)},
+		{Name: "touch user", Run: prefixed(checkTouchUser)},
+		{Name: "canceled context", Run: prefixed(checkCanceled)},
+		{Name: "concurrency", Run: func(ctx context.Context, repo credentialrepository.Repository) error {
+			return Stress(ctx, repo, prefix, workers, rounds)
+		}},
+		{Name: "concurrent compare and update", Run: func(ctx context.Context, repo credentialrepository.Repository) error {
+			return count(ctx, repo, prefix, workers)
+		}},
+	}
+}
+
+// Verify runs every check against repo.
+func Verify(ctx context.Context, repo credentialrepository.Repository) error {
+	var errs []error
+
+	for _, check := range Checks() {
+		err := check.Run(ctx, repo)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", check.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run runs every check as a subtest against a fresh repository, e.g.
+//
+//	func TestConformance(t *testing.T) {
+//		repositorytest.Run(t, func(t *testing.T) credentialrepository.Repository {
+//			return memory.NewRepository()
+//		})
+//	}
+func Run(t *testing.T, newRepository func(t *testing.T) credentialrepository.Repository) {
+	t.Helper()
+
+	for _, check := range Checks() {
+		t.Run(check.Name, func(t *testing.T) {
+			err := check.Run(t.Context(), newRepository(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func checkCreateGet(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	credential := domain.NewCredential(prefix+"conformance-create", "hash").WithEmail("create@example.com")
+
+	err := repo.CreateCredential(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	return expectCredential(ctx, repo, credential)
+}
+
+func checkGetMissing(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	_, err := repo.GetCredential(ctx, prefix+"conformance-missing")
+
+	return expectError(err, credentialrepository.ErrCredentialNotFound)
+}
+
+func checkCreateDuplicate(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	original := domain.NewCredential(prefix+"conformance-duplicate", "original")
+
+	err := repo.CreateCredential(ctx, original)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	err = repo.CreateCredential(ctx, domain.NewCredential(original.Username(), "duplicate"))
+
+	err = expectError(err, credentialrepository.ErrCredentialAlreadyExists)
+	if err != nil {
+		return err
+	}
+
+	// a rejected duplicate must not overwrite the original
+	return expectCredential(ctx, repo, original)
+}
+
+func checkDelete(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	credential := domain.NewCredential(prefix+"conformance-delete", "hash")
+
+	err := repo.CreateCredential(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	err = repo.DeleteCredential(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	_, err = repo.GetCredential(ctx, credential.Username())
+
+	err = expectError(err, credentialrepository.ErrCredentialNotFound)
+	if err != nil {
+		return err
+	}
+
+	// the username is free again
+	recreated := domain.NewCredential(credential.Username(), "recreated")
+
+	err = repo.CreateCredential(ctx, recreated)
+	if err != nil {
+		return fmt.Errorf("recreate: %w", err)
+	}
+
+	return expectCredential(ctx, repo, recreated)
+}
+
+func checkDeleteMissing(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	err := repo.DeleteCredential(ctx, domain.NewCredential(prefix+"conformance-delete-missing", "hash"))
+
+	return expectError(err, credentialrepository.ErrCredentialNotFound)
+}
+
+func checkUpdate(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	credential := domain.NewCredential(prefix+"conformance-update", "old")
+
+	err := repo.CreateCredential(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	// times are compared as instants, a repository may store them at microsecond precision in UTC
+	zone := time.FixedZone("UTC+9", 9*60*60) //nolint:mnd
+	updated := credential.WithPassword("new").
+		WithEmail("update@example.com").
+		WithEmailVerified(true).
+		WithTokensValidAfter(time.Date(2026, 1, 2, 3, 4, 5, 0, zone)). //nolint:mnd
+		WithDisabled(true).
+		WithFailedLogins(3).                                      //nolint:mnd
+		WithLockedUntil(time.Date(2026, 1, 2, 3, 19, 5, 0, zone)) //nolint:mnd
+
+	err = repo.CompareAndUpdateCredential(ctx, credential, updated)
+	if err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+
+	return expectCredential(ctx, repo, updated)
+}
+
+func checkCompareAndUpdate(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	err := repo.CreateCredential(ctx, domain.NewCredential(prefix+"conformance-compare", "old").
+		WithLockedUntil(time.Date(2026, 1, 2, 3, 4, 5, 6000, time.UTC))) //nolint:mnd
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	// the credential as read compares equal, whatever precision the repository stores times at
+	old, err := repo.GetCredential(ctx, prefix+"conformance-compare")
+	if err != nil {
+		return fmt.Errorf("get: %w", err)
+	}
+
+	updated := old.WithPassword("new").WithFailedLogins(1)
+
+	err = repo.CompareAndUpdateCredential(ctx, old, updated)
+	if err != nil {
+		return fmt.Errorf("compare and update: %w", err)
+	}
+
+	// old is outdated now, so a second writer that read it must not overwrite the first
+	err = expectError(repo.CompareAndUpdateCredential(ctx, old, old.WithDisabled(true)),
+		credentialrepository.ErrCredentialChanged)
+	if err != nil {
+		return err
+	}
+
+	return expectCredential(ctx, repo, updated)
+}
+
+func checkCompareAndUpdateMissing(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	credential := domain.NewCredential(prefix+"conformance-compare-missing", "hash")
+
+	err := expectError(repo.CompareAndUpdateCredential(ctx, credential, credential.WithPassword("new")),
+		credentialrepository.ErrCredentialNotFound)
+	if err != nil {
+		return err
+	}
+
+	// an update must not create the credential
+	_, err = repo.GetCredential(ctx, credential.Username())
+
+	return expectError(err, credentialrepository.ErrCredentialNotFound)
+}
+
+func checkUnicode(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	// names that only differ in a prefix, in case or in composition must not collide
+	credentials := []*domain.Credential{
+		domain.NewCredential(prefix+"conformance-ü-名前", "composed"),
+		domain.NewCredential(prefix+"conformance-u\u0308-名前", "decomposed"),
+		domain.NewCredential(prefix+"conformance-Ü-名前", "upper"),
+		domain.NewCredential(prefix+"conformance-ü-名前-2", "prefixed"),
+	}
+
+	for _, credential := range credentials {
+		err := repo.CreateCredential(ctx, credential)
+		if err != nil {
+			return fmt.Errorf("create %q: %w", credential.Username(), err)
+		}
+	}
+
+	for _, credential := range credentials {
+		err := expectCredential(ctx, repo, credential)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkList(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	// byte order puts upper case before lower case
+	credentials := []*domain.Credential{
+		domain.NewCredential(prefix+"conformance-list-Z", "hash"),
+		domain.NewCredential(prefix+"conformance-list-a", "hash").WithEmail("a@" + prefix + "list.example"),
+		domain.NewCredential(prefix+"conformance-list-b", "hash"),
+		domain.NewCredential(prefix+"conformance-list-ü", "hash").WithEmail("u@" + prefix + "list.example"),
+	}
+
+	for _, i := range []int{2, 0, 3, 1} {
+		err := repo.CreateCredential(ctx, credentials[i])
+		if err != nil {
+			return fmt.Errorf("create %q: %w", credentials[i].Username(), err)
+		}
+	}
+
+	// pages of two, each continuing after the last username of the previous one
+	var (
+		listed []string
+		after  string
+	)
+
+	for range 3 {
+		page, err := repo.ListCredentials(ctx, after, prefix+"conformance-list-", 2) //nolint:mnd
+		if err != nil {
+			return fmt.Errorf("list after %q: %w", after, err)
+		}
+
+		for _, credential := range page {
+			listed = append(listed, credential.Username())
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		after = page[len(page)-1].Username()
+	}
+
+	err := expectUsernames(listed, credentials)
+	if err != nil {
+		return err
+	}
+
+	// the search also matches emails, and listed credentials are complete
+	page, err := repo.ListCredentials(ctx, "", "@"+prefix+"list.example", 10) //nolint:mnd
+	if err != nil {
+		return fmt.Errorf("list by email: %w", err)
+	}
+
+	err = expectUsernames(usernames(page), []*domain.Credential{credentials[1], credentials[3]})
+	if err != nil {
+		return err
+	}
+
+	return expectCredential(ctx, repo, page[0])
+}
+
+func checkUser(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	credential := domain.NewCredential(prefix+"conformance-user", "hash")
+
+	err := repo.CreateCredential(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	created, err := repo.GetUser(ctx, credential.Username())
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	if created.CreatedAt().IsZero() || created.UpdatedAt().Before(created.CreatedAt()) {
+		return fmt.Errorf("%w: got created at %v, updated at %v",
+			ErrContractViolated, created.CreatedAt(), created.UpdatedAt())
+	}
+
+	// a new user has an empty profile
+	err = expectUser(ctx, repo, domain.NewUser(credential.Username(), time.Time{}, time.Time{}))
+	if err != nil {
+		return err
+	}
+
+	profile := created.WithDisplayName("Conformance Ü").
+		WithLocale("de-CH").
+		WithAttributes(map[string]string{"team": "名前", "empty": ""})
+
+	err = repo.UpdateUser(ctx, profile)
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+
+	// updating the credential keeps the profile, updating the profile keeps the credential
+	updated := credential.WithPassword("new")
+
+	err = repo.CompareAndUpdateCredential(ctx, credential, updated)
+	if err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+
+	err = expectUser(ctx, repo, profile)
+	if err != nil {
+		return err
+	}
+
+	got, err := repo.GetUser(ctx, credential.Username())
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	// timestamps are kept by the repository, which may store them at second precision
+	if !got.CreatedAt().Equal(created.CreatedAt()) || got.UpdatedAt().Before(created.UpdatedAt()) {
+		return fmt.Errorf("%w: got created at %v, updated at %v, want created at %v, updated at %v or later",
+			ErrContractViolated, got.CreatedAt(), got.UpdatedAt(), created.CreatedAt(), created.UpdatedAt())
+	}
+
+	return expectCredential(ctx, repo, updated)
+}
+
+func checkUserMissing(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	user := domain.NewUser(prefix+"conformance-user-missing", time.Time{}, time.Time{}).WithDisplayName("Missing")
+
+	_, err := repo.GetUser(ctx, user.Username())
+
+	err = expectError(err, credentialrepository.ErrCredentialNotFound)
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	err = expectError(repo.UpdateUser(ctx, user), credentialrepository.ErrCredentialNotFound)
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+
+	// an update of the profile must not create the credential
+	_, err = repo.GetCredential(ctx, user.Username())
+
+	return expectError(err, credentialrepository.ErrCredentialNotFound)
+}
+
+func checkTouchUser(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	credential := domain.NewCredential(prefix+"conformance-touch", "hash")
+
+	err := repo.CreateCredential(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	profile := domain.NewUser(credential.Username(), time.Time{}, time.Time{}).WithDisplayName("Touched")
+
+	err = repo.UpdateUser(ctx, profile)
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+
+	before, err := repo.GetUser(ctx, credential.Username())
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	// a write of the credential, e.g. a failed login, does not mark the profile as updated
+	err = repo.CompareAndUpdateCredential(ctx, credential, credential.WithFailedLogins(1))
+	if err != nil {
+		return fmt.Errorf("compare and update: %w", err)
+	}
+
+	got, err := repo.GetUser(ctx, credential.Username())
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	if !got.UpdatedAt().Equal(before.UpdatedAt()) {
+		return fmt.Errorf("%w: got updated at %v after a credential write, want %v",
+			ErrContractViolated, got.UpdatedAt(), before.UpdatedAt())
+	}
+
+	err = repo.TouchUser(ctx, credential.Username())
+	if err != nil {
+		return fmt.Errorf("touch user: %w", err)
+	}
+
+	got, err = repo.GetUser(ctx, credential.Username())
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	if !got.CreatedAt().Equal(before.CreatedAt()) || got.UpdatedAt().Before(before.UpdatedAt()) {
+		return fmt.Errorf("%w: got created at %v, updated at %v, want created at %v, updated at %v or later",
+			ErrContractViolated, got.CreatedAt(), got.UpdatedAt(), before.CreatedAt(), before.UpdatedAt())
+	}
+
+	// touching keeps the profile
+	err = expectUser(ctx, repo, profile)
+	if err != nil {
+		return err
+	}
+
+	missing := prefix + "conformance-touch-missing"
+
+	err = expectError(repo.TouchUser(ctx, missing), credentialrepository.ErrCredentialNotFound)
+	if err != nil {
+		return fmt.Errorf("touch missing user: %w", err)
+	}
+
+	// touching must not create the credential
+	_, err = repo.GetCredential(ctx, missing)
+
+	return expectError(err, credentialrepository.ErrCredentialNotFound)
+}
+
+func checkCanceled(ctx context.Context, repo credentialrepository.Repository, prefix string) error {
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	credential := domain.NewCredential(prefix+"conformance-canceled", "hash")
+
+	err := expectError(repo.CreateCredential(canceled, credential), context.Canceled)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	_, err = repo.GetCredential(canceled, credential.Username())
+
+	err = expectError(err, context.Canceled)
+	if err != nil {
+		return fmt.Errorf("get: %w", err)
+	}
+
+	err = expectError(repo.CompareAndUpdateCredential(canceled, credential, credential), context.Canceled)
+	if err != nil {
+		return fmt.Errorf("compare and update: %w", err)
+	}
+
+	err = expectError(repo.DeleteCredential(canceled, credential), context.Canceled)
+	if err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	_, err = repo.ListCredentials(canceled, "", "", 1)
+
+	err = expectError(err, context.Canceled)
+	if err != nil {
+		return fmt.Errorf("list: %w", err)
+	}
+
+	_, err = repo.GetUser(canceled, credential.Username())
+
+	err = expectError(err, context.Canceled)
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	err = expectError(repo.UpdateUser(canceled, domain.NewUser(credential.Username(), time.Time{}, time.Time{})),
+		context.Canceled)
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+
+	err = expectError(repo.TouchUser(canceled, credential.Username()), context.Canceled)
+	if err != nil {
+		return fmt.Errorf("touch user: %w", err)
+	}
+
+	// a canceled create must not have stored anything
+	_, err = repo.GetCredential(ctx, credential.Username())
+
+	return expectError(err, credentialrepository.ErrCredentialNotFound)
+}
+
+// newPrefix returns a prefix no earlier run has used.
+func newPrefix() string {
+	return rand.Text() + "-"
+}
+
+func expectCredential(ctx context.Context, repo credentialrepository.Repository, want *domain.Credential) error {
+	got, err := repo.GetCredential(ctx, want.Username())
+	if err != nil {
+		return fmt.Errorf("get %q: %w", want.Username(), err)
+	}
+
+	if got.Username() != want.Username() || got.Password() != want.Password() {
+		return fmt.Errorf("%w: got %q with password %q, want %q with password %q",
+			ErrContractViolated, got.Username(), got.Password(), want.Username(), want.Password())
+	}
+
+	if got.Email() != want.Email() || got.EmailVerified() != want.EmailVerified() {
+		return fmt.Errorf("%w: got email %q verified %t, want %q verified %t",
+			ErrContractViolated, got.Email(), got.EmailVerified(), want.Email(), want.EmailVerified())
+	}
+
+	if !got.TokensValidAfter().Equal(want.TokensValidAfter()) {
+		return fmt.Errorf("%w: got tokens valid after %v, want %v",
+			ErrContractViolated, got.TokensValidAfter(), want.TokensValidAfter())
+	}
+
+	if got.Disabled() != want.Disabled() || got.FailedLogins() != want.FailedLogins() ||
+		!got.LockedUntil().Equal(want.LockedUntil()) {
+		return fmt.Errorf("%w: got disabled %t, %d failed logins, locked until %v, "+
+			"want disabled %t, %d failed logins, locked until %v", ErrContractViolated,
+			got.Disabled(), got.FailedLogins(), got.LockedUntil(),
+			want.Disabled(), want.FailedLogins(), want.LockedUntil())
+	}
+
+	return nil
+}
+
+func expectUsernames(got []string, want []*domain.Credential) error {
+	if !slices.Equal(got, usernames(want)) {
+		return fmt.Errorf("%w: got usernames %q, want %q", ErrContractViolated, got, usernames(want))
+	}
+
+	return nil
+}
+
+func usernames(credentials []*domain.Credential) []string {
+	ret := make([]string, 0, len(credentials))
+	for _, credential := range credentials {
+		ret = append(ret, credential.Username())
+	}
+
+	return ret
+}
+
+// expectUser compares the profile fields, the timestamps are up to the repository.
+func expectUser(ctx context.Context, repo credentialrepository.Repository, want *domain.User) error {
+	got, err := repo.GetUser(ctx, want.Username())
+	if err != nil {
+		return fmt.Errorf("get user %q: %w", want.Username(), err)
+	}
+
+	if got.DisplayName() != want.DisplayName() || got.Locale() != want.Locale() ||
+		!maps.Equal(got.Attributes(), want.Attributes()) {
+		return fmt.Errorf("%w: got profile %q %q %v, want %q %q %v", ErrContractViolated,
+			got.DisplayName(), got.Locale(), got.Attributes(), want.DisplayName(), want.Locale(), want.Attributes())
+	}
+
+	return nil
+}
+
+func expectError(err, want error) error {
+	if !errors.Is(err, want) {
+		return fmt.Errorf("%w: got error %v, want %v", ErrContractViolated, err, want)
+	}
+
+	return nil
+}
@@ -0,0 +1,4 @@
+// Package repositorytest holds the contract every credentialrepository.Repository implementation must fulfil.
+// New backends are verified with Run from a test or with Verify, e.g. make conformance,
+// which runs the checks against every backend under the race detector.
+package repositorytest
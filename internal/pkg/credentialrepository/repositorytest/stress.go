@@ -0,0 +1,213 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+// RunStress runs Stress as a test with more workers and rounds than the concurrency check of Run,
+// so go test -race sees the repository under real contention. It is skipped with -short.
+func RunStress(t *testing.T, newRepository func(t *testing.T) credentialrepository.Repository) {
+	t.Helper()
+
+	const (
+		workers = 32
+		rounds  = 20
+	)
+
+	if testing.Short() {
+		t.Skip("stress test in short mode")
+	}
+
+	err := Stress(t.Context(), newRepository(t), newPrefix(), workers, rounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Stress hammers the repository from concurrent goroutines, like concurrent HTTP requests do.
+// Every goroutine works on its own user and all of them race for a shared one,
+// of which exactly one create and one delete must win. All usernames start with prefix.
+func Stress(ctx context.Context, repo credentialrepository.Repository, prefix string, workers, rounds int) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	report := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		errs = append(errs, err)
+	}
+
+	for round := range rounds {
+		shared := domain.NewCredential(fmt.Sprintf("%sstress-shared-%d", prefix, round), "shared")
+
+		var created, deleted atomic.Int32
+
+		for worker := range workers {
+			wg.Go(func() {
+				own := domain.NewCredential(fmt.Sprintf("%sstress-%d-%d", prefix, round, worker), fmt.Sprintf("hash-%d", worker))
+
+				err := lifecycle(ctx, repo, own)
+				if err != nil {
+					report(err)
+				}
+
+				err = contend(ctx, repo, shared, &created)
+				if err != nil {
+					report(err)
+				}
+			})
+		}
+
+		wg.Wait()
+
+		for range workers {
+			wg.Go(func() {
+				err := repo.DeleteCredential(ctx, shared)
+				switch {
+				case err == nil:
+					deleted.Add(1)
+				case !errors.Is(err, credentialrepository.ErrCredentialNotFound):
+					report(fmt.Errorf("delete %s: %w", shared.Username(), err))
+				}
+			})
+		}
+
+		wg.Wait()
+
+		if created.Load() != 1 || deleted.Load() != 1 {
+			report(fmt.Errorf("%w: %s was created %d and deleted %d times",
+				ErrContractViolated, shared.Username(), created.Load(), deleted.Load()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// lifecycle creates, reads and deletes a credential no one else touches.
+func lifecycle(ctx context.Context, repo credentialrepository.Repository, credential *domain.Credential) error {
+	err := repo.CreateCredential(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", credential.Username(), err)
+	}
+
+	got, err := repo.GetCredential(ctx, credential.Username())
+	if err != nil {
+		return fmt.Errorf("get %s: %w", credential.Username(), err)
+	}
+
+	if got.Password() != credential.Password() {
+		return fmt.Errorf("%w: %s has password %q, want %q",
+			ErrContractViolated, credential.Username(), got.Password(), credential.Password())
+	}
+
+	err = repo.DeleteCredential(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("delete %s: %w", credential.Username(), err)
+	}
+
+	_, err = repo.GetCredential(ctx, credential.Username())
+	if !errors.Is(err, credentialrepository.ErrCredentialNotFound) {
+		return fmt.Errorf("%w: %s still exists after delete: %w", ErrContractViolated, credential.Username(), err)
+	}
+
+	return nil
+}
+
+// contend creates a credential other goroutines create at the same time and reads it back.
+// Readers must never observe a partially written credential.
+func contend(
+	ctx context.Context,
+	repo credentialrepository.Repository,
+	credential *domain.Credential,
+	created *atomic.Int32,
+) error {
+	err := repo.CreateCredential(ctx, credential)
+	switch {
+	case err == nil:
+		created.Add(1)
+	case !errors.Is(err, credentialrepository.ErrCredentialAlreadyExists):
+		return fmt.Errorf("create %s: %w", credential.Username(), err)
+	}
+
+	got, err := repo.GetCredential(ctx, credential.Username())
+	if err != nil {
+		return fmt.Errorf("get %s: %w", credential.Username(), err)
+	}
+
+	if got.Password() != credential.Password() {
+		return fmt.Errorf("%w: %s has password %q, want %q",
+			ErrContractViolated, credential.Username(), got.Password(), credential.Password())
+	}
+
+	return nil
+}
+
+// count increments the failed logins of one credential from concurrent goroutines, each rereading the
+// credential until no one changed it in between, like failed logins do. No increment may get lost.
+func count(ctx context.Context, repo credentialrepository.Repository, prefix string, workers int) error {
+	credential := domain.NewCredential(prefix+"stress-count", "hash")
+
+	err := repo.CreateCredential(ctx, credential)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", credential.Username(), err)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, workers)
+	)
+
+	for worker := range workers {
+		wg.Go(func() {
+			errs[worker] = increment(ctx, repo, credential.Username())
+		})
+	}
+
+	wg.Wait()
+
+	err = errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	got, err := repo.GetCredential(ctx, credential.Username())
+	if err != nil {
+		return fmt.Errorf("get %s: %w", credential.Username(), err)
+	}
+
+	if got.FailedLogins() != workers {
+		return fmt.Errorf("%w: %s has %d failed logins, want %d",
+			ErrContractViolated, credential.Username(), got.FailedLogins(), workers)
+	}
+
+	return nil
+}
+
+func increment(ctx context.Context, repo credentialrepository.Repository, username string) error {
+	for {
+		credential, err := repo.GetCredential(ctx, username)
+		if err != nil {
+			return fmt.Errorf("get %s: %w", username, err)
+		}
+
+		err = repo.CompareAndUpdateCredential(ctx, credential, credential.WithFailedLogins(credential.FailedLogins()+1))
+		switch {
+		case err == nil:
+			return nil
+		case !errors.Is(err, credentialrepository.ErrCredentialChanged):
+			return fmt.Errorf("compare and update %s: %w", username, err)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package sqlite
+
+import "errors"
+
+var ErrUnknownSchemaVersion = errors.New("database was created by a newer version")
@@ -0,0 +1,69 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// migrations are applied in order, each exactly once. The schema version is kept in PRAGMA user_version,
+// which is the number of applied migrations. Never edit a released migration, append a new one instead.
+var migrations = []string{ //nolint:gochecknoglobals
+	`CREATE TABLE credentials (
+		username   TEXT PRIMARY KEY NOT NULL,
+		password   TEXT NOT NULL,
+		created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP
+	) STRICT, WITHOUT ROWID`,
+	`ALTER TABLE credentials ADD COLUMN tokens_valid_after TEXT`,
+	`ALTER TABLE credentials ADD COLUMN email TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE credentials ADD COLUMN email_verified INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE credentials ADD COLUMN display_name TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE credentials ADD COLUMN locale TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE credentials ADD COLUMN attributes TEXT NOT NULL DEFAULT '{}'`,
+	`ALTER TABLE credentials ADD COLUMN disabled INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE credentials ADD COLUMN failed_logins INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE credentials ADD COLUMN locked_until TEXT`,
+}
+
+func migrate(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin migration: %w", err)
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var version int
+
+	err = tx.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version)
+	if err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	if version > len(migrations) {
+		return fmt.Errorf("schema version %d: %w", version, ErrUnknownSchemaVersion)
+	}
+
+	for i, migration := range migrations[version:] {
+		_, err := tx.ExecContext(ctx, migration)
+		if err != nil {
+			return fmt.Errorf("failed to apply migration %d: %w", version+i+1, err)
+		}
+	}
+
+	// PRAGMA does not take parameters
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", len(migrations)))
+	if err != nil {
+		return fmt.Errorf("failed to write schema version: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit migration: %w", err)
+	}
+
+	return nil
+}
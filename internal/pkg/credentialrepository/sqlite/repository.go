@@ -0,0 +1,338 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	_ "modernc.org/sqlite" // pure Go driver, so CGO_ENABLED=0 builds keep working
+)
+
+var _ credentialrepository.Repository = (*Repository)(nil)
+
+// timestampLayout is the format of CURRENT_TIMESTAMP, in UTC.
+const timestampLayout = time.DateTime
+
+type Repository struct {
+	db *sql.DB
+}
+
+// NewRepository opens the SQLite database at path, creating it if needed, and migrates its schema.
+func NewRepository(ctx context.Context, path string) (*Repository, error) {
+	const createPerm = 0750
+
+	err := os.MkdirAll(filepath.Dir(path), createPerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	dsn := "file:" + filepath.ToSlash(path) +
+		"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)"
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// SQLite allows a single writer, serializing in the pool is cheaper than retrying busy errors
+	db.SetMaxOpenConns(1)
+
+	err = migrate(ctx, db)
+	if err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) Close() error {
+	return r.db.Close() //nolint:wrapcheck
+}
+
+// credentialColumns are read by scanCredential, in its order.
+const credentialColumns = "username, password, email, email_verified, tokens_valid_after, " +
+	"disabled, failed_logins, locked_until"
+
+func (r *Repository) CreateCredential(ctx context.Context, credential *domain.Credential) error {
+	result, err := r.db.ExecContext(ctx,
+		"INSERT INTO credentials (username, password, email, email_verified, tokens_valid_after, "+
+			"disabled, failed_logins, locked_until) VALUES (?, ?, ?, ?, ?, ?, ?, ?) "+
+			"ON CONFLICT (username) DO NOTHING",
+		credential.Username(), credential.Password(), credential.Email(), credential.EmailVerified(),
+		formatTime(credential.TokensValidAfter()), credential.Disabled(), credential.FailedLogins(),
+		formatTime(credential.LockedUntil()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create credential: %w", err)
+	}
+
+	return expectOneRow(result, credentialrepository.ErrCredentialAlreadyExists)
+}
+
+func (r *Repository) DeleteCredential(ctx context.Context, credential *domain.Credential) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM credentials WHERE username = ?", credential.Username())
+	if err != nil {
+		return fmt.Errorf("failed to delete credential: %w", err)
+	}
+
+	return expectOneRow(result, credentialrepository.ErrCredentialNotFound)
+}
+
+// CompareAndUpdateCredential compares with IS, which treats two NULL times as equal.
+func (r *Repository) CompareAndUpdateCredential(ctx context.Context, old, credential *domain.Credential) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE credentials SET password = ?, email = ?, email_verified = ?, tokens_valid_after = ?, "+
+			"disabled = ?, failed_logins = ?, locked_until = ? "+
+			"WHERE username = ? AND password = ? AND email = ? AND email_verified = ? AND tokens_valid_after IS ? "+
+			"AND disabled = ? AND failed_logins = ? AND locked_until IS ?",
+		credential.Password(), credential.Email(), credential.EmailVerified(), formatTime(credential.TokensValidAfter()),
+		credential.Disabled(), credential.FailedLogins(), formatTime(credential.LockedUntil()),
+		credential.Username(),
+		old.Password(), old.Email(), old.EmailVerified(), formatTime(old.TokensValidAfter()),
+		old.Disabled(), old.FailedLogins(), formatTime(old.LockedUntil()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update credential: %w", err)
+	}
+
+	err = expectOneRow(result, credentialrepository.ErrCredentialChanged)
+	if err != nil {
+		return r.changedOrMissing(ctx, credential.Username(), err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetCredential(ctx context.Context, username string) (*domain.Credential, error) {
+	credential, err := scanCredential(r.db.QueryRowContext(ctx,
+		"SELECT "+credentialColumns+" FROM credentials WHERE username = ?", username,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, credentialrepository.ErrCredentialNotFound
+		}
+
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+// ListCredentials matches the search with instr, so it needs no escaping like LIKE would.
+func (r *Repository) ListCredentials(
+	ctx context.Context,
+	after string,
+	search string,
+	limit int,
+) ([]*domain.Credential, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+credentialColumns+" FROM credentials "+
+			"WHERE username > ? AND (? = '' OR instr(username, ?) > 0 OR instr(email, ?) > 0) "+
+			"ORDER BY username LIMIT ?",
+		after, search, search, search, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var credentials []*domain.Credential
+
+	for rows.Next() {
+		credential, err := scanCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		credentials = append(credentials, credential)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	return credentials, nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, username string) (*domain.User, error) {
+	var (
+		displayName string
+		locale      string
+		attributes  string
+		createdAt   string
+		updatedAt   string
+	)
+
+	err := r.db.QueryRowContext(ctx,
+		"SELECT display_name, locale, attributes, created_at, updated_at FROM credentials WHERE username = ?", username,
+	).Scan(&displayName, &locale, &attributes, &createdAt, &updatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, credentialrepository.ErrCredentialNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read user: %w", err)
+	}
+
+	var attributeMap map[string]string
+
+	err = json.Unmarshal([]byte(attributes), &attributeMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode attributes: %w", err)
+	}
+
+	created, err := time.Parse(timestampLayout, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse created_at: %w", err)
+	}
+
+	updated, err := time.Parse(timestampLayout, updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse updated_at: %w", err)
+	}
+
+	return domain.NewUser(username, created, updated).
+		WithDisplayName(displayName).
+		WithLocale(locale).
+		WithAttributes(attributeMap), nil
+}
+
+func (r *Repository) UpdateUser(ctx context.Context, user *domain.User) error {
+	attributes := user.Attributes()
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return fmt.Errorf("failed to encode attributes: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE credentials SET display_name = ?, locale = ?, attributes = ?, updated_at = CURRENT_TIMESTAMP "+
+			"WHERE username = ?",
+		user.DisplayName(), user.Locale(), string(data), user.Username(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return expectOneRow(result, credentialrepository.ErrCredentialNotFound)
+}
+
+func (r *Repository) TouchUser(ctx context.Context, username string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE credentials SET updated_at = CURRENT_TIMESTAMP WHERE username = ?", username)
+	if err != nil {
+		return fmt.Errorf("failed to touch user: %w", err)
+	}
+
+	return expectOneRow(result, credentialrepository.ErrCredentialNotFound)
+}
+
+// scanCredential reads the credentialColumns of a *sql.Row or the current row of *sql.Rows.
+func scanCredential(row interface{ Scan(dest ...any) error }) (*domain.Credential, error) {
+	var (
+		username         string
+		password         string
+		email            string
+		emailVerified    bool
+		tokensValidAfter sql.NullString
+		disabled         bool
+		failedLogins     int
+		lockedUntil      sql.NullString
+	)
+
+	err := row.Scan(&username, &password, &email, &emailVerified, &tokensValidAfter,
+		&disabled, &failedLogins, &lockedUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credential: %w", err)
+	}
+
+	validAfter, err := parseTime(tokensValidAfter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tokens_valid_after: %w", err)
+	}
+
+	locked, err := parseTime(lockedUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse locked_until: %w", err)
+	}
+
+	return domain.NewCredential(username, password).
+		WithEmail(email).
+		WithEmailVerified(emailVerified).
+		WithTokensValidAfter(validAfter).
+		WithDisabled(disabled).
+		WithFailedLogins(failedLogins).
+		WithLockedUntil(locked), nil
+}
+
+// parseTime reads a time written by formatTime, NULL is the zero time.
+func parseTime(value sql.NullString) (time.Time, error) {
+	if !value.Valid {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value.String)
+	if err != nil {
+		return time.Time{}, err //nolint:wrapcheck
+	}
+
+	return t, nil
+}
+
+// formatTime stores the zero time as NULL and other times as RFC 3339 text in UTC.
+func formatTime(t time.Time) sql.NullString {
+	if t.IsZero() {
+		return sql.NullString{String: "", Valid: false}
+	}
+
+	return sql.NullString{String: t.UTC().Format(time.RFC3339Nano), Valid: true}
+}
+
+// changedOrMissing tells an update that matched no row because the credential changed, err,
+// from one that matched none because there is no credential.
+func (r *Repository) changedOrMissing(ctx context.Context, username string, err error) error {
+	var exists bool
+
+	scanErr := r.db.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM credentials WHERE username = ?)", username,
+	).Scan(&exists)
+	if scanErr != nil {
+		return fmt.Errorf("failed to check credential: %w", scanErr)
+	}
+
+	if !exists {
+		return credentialrepository.ErrCredentialNotFound
+	}
+
+	return err
+}
+
+// expectOneRow returns fresh unless the statement affected exactly one row.
+func expectOneRow(result sql.Result, fresh error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to count affected rows: %w", err)
+	}
+
+	if affected != 1 {
+		return fresh
+	}
+
+	return nil
+}
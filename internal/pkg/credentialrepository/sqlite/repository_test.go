@@ -0,0 +1,37 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/repositorytest"
+	"github.com/neatflowcv/key-stone/internal/pkg/credentialrepository/sqlite"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, newRepository)
+}
+
+func TestStress(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.RunStress(t, newRepository)
+}
+
+func newRepository(t *testing.T) credentialrepository.Repository {
+	t.Helper()
+
+	repo, err := sqlite.NewRepository(t.Context(), filepath.Join(t.TempDir(), "credentials.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = repo.Close()
+	})
+
+	return repo
+}
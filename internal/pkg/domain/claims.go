@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// Claims are the contents of a token: the registered JWT claims, the session the token
+// belongs to, the granted scope and any custom claims. Claims are immutable, the With methods return a copy.
+type Claims struct {
+	id        string
+	issuer    string
+	subject   string
+	audience  []string
+	sessionID string
+	clientID  string
+	scope     []string
+	issuedAt  time.Time
+	notBefore time.Time
+	expiresAt time.Time
+	custom    map[string]any
+}
+
+// NewClaims returns the claims of a token valid from issuedAt until expiresAt.
+// The issuer of generated tokens is always decided by the generator.
+func NewClaims(id, subject string, issuedAt, expiresAt time.Time) *Claims {
+	return &Claims{
+		id:        id,
+		issuer:    "",
+		subject:   subject,
+		audience:  nil,
+		sessionID: "",
+		clientID:  "",
+		scope:     nil,
+		issuedAt:  issuedAt,
+		notBefore: issuedAt,
+		expiresAt: expiresAt,
+		custom:    nil,
+	}
+}
+
+// IsRegisteredClaim reports whether name is a claim modelled by Claims itself, which custom claims cannot override.
+func IsRegisteredClaim(name string) bool {
+	switch name {
+	case "jti", "iss", "sub", "aud", "sid", "client_id", "scope", "iat", "nbf", "exp":
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *Claims) ID() string {
+	return c.id
+}
+
+func (c *Claims) Issuer() string {
+	return c.issuer
+}
+
+func (c *Claims) Subject() string {
+	return c.subject
+}
+
+func (c *Claims) Audience() []string {
+	return slices.Clone(c.audience)
+}
+
+func (c *Claims) SessionID() string {
+	return c.sessionID
+}
+
+// ClientID returns the client the token was issued to, if any.
+func (c *Claims) ClientID() string {
+	return c.clientID
+}
+
+// Scope returns the granted scopes.
+func (c *Claims) Scope() []string {
+	return slices.Clone(c.scope)
+}
+
+// HasAudience reports whether the token was issued for the audience.
+func (c *Claims) HasAudience(audience string) bool {
+	return slices.Contains(c.audience, audience)
+}
+
+// HasScope reports whether every required scope was granted.
+func (c *Claims) HasScope(required ...string) bool {
+	for _, scope := range required {
+		if !slices.Contains(c.scope, scope) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Claims) IssuedAt() time.Time {
+	return c.issuedAt
+}
+
+func (c *Claims) NotBefore() time.Time {
+	return c.notBefore
+}
+
+func (c *Claims) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+// Custom returns the custom claim with the given name.
+func (c *Claims) Custom(name string) (any, bool) {
+	value, ok := c.custom[name]
+
+	return value, ok
+}
+
+// CustomClaims returns all custom claims.
+func (c *Claims) CustomClaims() map[string]any {
+	return maps.Clone(c.custom)
+}
+
+// Strings returns a custom claim holding a string or a list of strings, e.g. roles.
+func (c *Claims) Strings(name string) []string {
+	switch value := c.custom[name].(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return slices.Clone(value)
+	case []any:
+		var ret []string
+
+		for _, item := range value {
+			if item, ok := item.(string); ok {
+				ret = append(ret, item)
+			}
+		}
+
+		return ret
+	default:
+		return nil
+	}
+}
+
+func (c *Claims) WithIssuer(issuer string) *Claims {
+	ret := c.clone()
+	ret.issuer = issuer
+
+	return ret
+}
+
+func (c *Claims) WithAudience(audience ...string) *Claims {
+	ret := c.clone()
+	ret.audience = slices.Clone(audience)
+
+	return ret
+}
+
+func (c *Claims) WithSessionID(sessionID string) *Claims {
+	ret := c.clone()
+	ret.sessionID = sessionID
+
+	return ret
+}
+
+func (c *Claims) WithClientID(clientID string) *Claims {
+	ret := c.clone()
+	ret.clientID = clientID
+
+	return ret
+}
+
+func (c *Claims) WithScope(scope ...string) *Claims {
+	ret := c.clone()
+	ret.scope = slices.Clone(scope)
+
+	return ret
+}
+
+func (c *Claims) WithNotBefore(notBefore time.Time) *Claims {
+	ret := c.clone()
+	ret.notBefore = notBefore
+
+	return ret
+}
+
+// WithCustomClaims adds custom claims, replacing custom claims of the same name.
+// Returns ErrRegisteredClaim if a name collides with a registered claim.
+func (c *Claims) WithCustomClaims(custom map[string]any) (*Claims, error) {
+	ret := c.clone()
+	if ret.custom == nil && len(custom) > 0 {
+		ret.custom = make(map[string]any, len(custom))
+	}
+
+	for name, value := range custom {
+		if IsRegisteredClaim(name) {
+			return nil, fmt.Errorf("claim %q: %w", name, ErrRegisteredClaim)
+		}
+
+		ret.custom[name] = value
+	}
+
+	return ret, nil
+}
+
+func (c *Claims) clone() *Claims {
+	ret := *c
+	ret.audience = slices.Clone(c.audience)
+	ret.scope = slices.Clone(c.scope)
+	ret.custom = maps.Clone(c.custom)
+
+	return &ret
+}
@@ -0,0 +1,23 @@
+package domain
+
+// Client is a service that authenticates itself to key-stone, e.g. an API gateway introspecting tokens.
+type Client struct {
+	id     string
+	secret string
+}
+
+func NewClient(id, secret string) *Client {
+	return &Client{
+		id:     id,
+		secret: secret,
+	}
+}
+
+func (c *Client) ID() string {
+	return c.id
+}
+
+// Secret returns the hashed secret of the client.
+func (c *Client) Secret() string {
+	return c.secret
+}
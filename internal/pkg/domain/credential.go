@@ -1,14 +1,28 @@
 package domain
 
+import "time"
+
 type Credential struct {
-	username string
-	password string
+	username         string
+	password         string
+	email            string
+	emailVerified    bool
+	tokensValidAfter time.Time
+	disabled         bool
+	failedLogins     int
+	lockedUntil      time.Time
 }
 
 func NewCredential(username, password string) *Credential {
 	return &Credential{
-		username: username,
-		password: password,
+		username:         username,
+		password:         password,
+		email:            "",
+		emailVerified:    false,
+		tokensValidAfter: time.Time{},
+		disabled:         false,
+		failedLogins:     0,
+		lockedUntil:      time.Time{},
 	}
 }
 
@@ -19,3 +33,108 @@ func (c *Credential) Username() string {
 func (c *Credential) Password() string {
 	return c.password
 }
+
+// Email is the address password resets are sent to, empty if the user has none.
+func (c *Credential) Email() string {
+	return c.email
+}
+
+// EmailVerified tells whether the user proved to receive mails sent to the email.
+func (c *Credential) EmailVerified() bool {
+	return c.emailVerified
+}
+
+// TokensValidAfter is the time tokens of the user must be issued at or after, zero if every token is.
+// It is moved forward when the password changes, so tokens issued with the old password stop working.
+func (c *Credential) TokensValidAfter() time.Time {
+	return c.tokensValidAfter
+}
+
+// Disabled users cannot log in and their tokens are rejected, until an administrator enables them again.
+func (c *Credential) Disabled() bool {
+	return c.disabled
+}
+
+// FailedLogins counts the failed logins since the last successful one or the last lockout.
+func (c *Credential) FailedLogins() int {
+	return c.failedLogins
+}
+
+// LockedUntil is the time a lockout for too many failed logins ends, zero if the user was never locked.
+func (c *Credential) LockedUntil() time.Time {
+	return c.lockedUntil
+}
+
+func (c *Credential) IsLocked(now time.Time) bool {
+	return now.Before(c.lockedUntil)
+}
+
+// Equal reports whether both credentials have the same fields, times are compared as instants.
+func (c *Credential) Equal(other *Credential) bool {
+	return c.username == other.username &&
+		c.password == other.password &&
+		c.email == other.email &&
+		c.emailVerified == other.emailVerified &&
+		c.tokensValidAfter.Equal(other.tokensValidAfter) &&
+		c.disabled == other.disabled &&
+		c.failedLogins == other.failedLogins &&
+		c.lockedUntil.Equal(other.lockedUntil)
+}
+
+// WithPassword replaces the password hash and keeps everything else.
+func (c *Credential) WithPassword(password string) *Credential {
+	ret := c.clone()
+	ret.password = password
+
+	return ret
+}
+
+// WithEmail replaces the email. A different email is unverified.
+func (c *Credential) WithEmail(email string) *Credential {
+	ret := c.clone()
+	ret.emailVerified = c.emailVerified && email == c.email
+	ret.email = email
+
+	return ret
+}
+
+func (c *Credential) WithEmailVerified(emailVerified bool) *Credential {
+	ret := c.clone()
+	ret.emailVerified = emailVerified
+
+	return ret
+}
+
+func (c *Credential) WithTokensValidAfter(tokensValidAfter time.Time) *Credential {
+	ret := c.clone()
+	ret.tokensValidAfter = tokensValidAfter
+
+	return ret
+}
+
+func (c *Credential) WithDisabled(disabled bool) *Credential {
+	ret := c.clone()
+	ret.disabled = disabled
+
+	return ret
+}
+
+func (c *Credential) WithFailedLogins(failedLogins int) *Credential {
+	ret := c.clone()
+	ret.failedLogins = failedLogins
+
+	return ret
+}
+
+func (c *Credential) WithLockedUntil(lockedUntil time.Time) *Credential {
+	ret := c.clone()
+	ret.lockedUntil = lockedUntil
+
+	return ret
+}
+
+func (c *Credential) clone() *Credential {
+	ret := *c
+
+	return &ret
+}
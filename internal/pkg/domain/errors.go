@@ -0,0 +1,14 @@
+package domain
+
+import "errors"
+
+var (
+	ErrRegisteredClaim = errors.New("claim is registered")
+
+	ErrUsernameInvalid  = errors.New("username is invalid")
+	ErrUsernameReserved = errors.New("username is reserved")
+
+	ErrPasswordInvalid          = errors.New("password is invalid")
+	ErrPasswordContainsUsername = errors.New("password contains the username")
+	ErrPasswordWeak             = errors.New("password is too weak")
+)
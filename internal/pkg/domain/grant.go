@@ -0,0 +1,67 @@
+package domain
+
+import "slices"
+
+// Grant is what a user may request tokens for: the audiences, i.e. the services accepting the
+// tokens, and the scopes.
+type Grant struct {
+	audience []string
+	scope    []string
+}
+
+func NewGrant(audience, scope []string) *Grant {
+	return &Grant{
+		audience: slices.Clone(audience),
+		scope:    slices.Clone(scope),
+	}
+}
+
+func (g *Grant) Audience() []string {
+	return slices.Clone(g.audience)
+}
+
+func (g *Grant) Scope() []string {
+	return slices.Clone(g.scope)
+}
+
+// AllowsAudience reports whether every requested audience is granted.
+func (g *Grant) AllowsAudience(requested ...string) bool {
+	for _, audience := range requested {
+		if !slices.Contains(g.audience, audience) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RestrictAudience returns the requested audiences that are still granted.
+func (g *Grant) RestrictAudience(requested []string) []string {
+	var ret []string
+
+	for _, audience := range requested {
+		if slices.Contains(g.audience, audience) {
+			ret = append(ret, audience)
+		}
+	}
+
+	return ret
+}
+
+// RestrictScope returns the requested scopes that are granted.
+// Without a request every granted scope is returned.
+func (g *Grant) RestrictScope(requested []string) []string {
+	if requested == nil {
+		return slices.Clone(g.scope)
+	}
+
+	var ret []string
+
+	for _, scope := range requested {
+		if slices.Contains(g.scope, scope) && !slices.Contains(ret, scope) {
+			ret = append(ret, scope)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// LockoutPolicy decides when repeated failed logins lock a user out.
+// After maxFailedLogins failed logins in a row the user is locked for duration. Zero maxFailedLogins
+// never locks anybody out.
+type LockoutPolicy struct {
+	maxFailedLogins int
+	duration        time.Duration
+}
+
+func NewLockoutPolicy() *LockoutPolicy {
+	return &LockoutPolicy{
+		maxFailedLogins: 0,
+		duration:        15 * time.Minute, //nolint:mnd
+	}
+}
+
+func (l *LockoutPolicy) MaxFailedLogins() int {
+	return l.maxFailedLogins
+}
+
+func (l *LockoutPolicy) Duration() time.Duration {
+	return l.duration
+}
+
+func (l *LockoutPolicy) WithMaxFailedLogins(maxFailedLogins int) *LockoutPolicy {
+	ret := *l
+	ret.maxFailedLogins = maxFailedLogins
+
+	return &ret
+}
+
+func (l *LockoutPolicy) WithDuration(duration time.Duration) *LockoutPolicy {
+	ret := *l
+	ret.duration = duration
+
+	return &ret
+}
+
+// FailedLogin records a failed login of the credential at now, locking it once too many failed in a row.
+func (l *LockoutPolicy) FailedLogin(cred *Credential, now time.Time) *Credential {
+	if l.maxFailedLogins <= 0 {
+		return cred
+	}
+
+	failed := cred.FailedLogins() + 1
+	if failed < l.maxFailedLogins {
+		return cred.WithFailedLogins(failed)
+	}
+
+	return cred.WithFailedLogins(0).WithLockedUntil(now.Add(l.duration))
+}
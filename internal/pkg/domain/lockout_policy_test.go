@@ -0,0 +1,81 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+func TestLockoutPolicyFailedLogin(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		maxFailedLogins int
+		failedBefore    int
+		wantFailed      int
+		wantLocked      bool
+	}{
+		{name: "disabled policy never counts", maxFailedLogins: 0, failedBefore: 9, wantFailed: 9, wantLocked: false},
+		{name: "first failure is counted", maxFailedLogins: 3, failedBefore: 0, wantFailed: 1, wantLocked: false},
+		{name: "one below the threshold", maxFailedLogins: 3, failedBefore: 1, wantFailed: 2, wantLocked: false},
+		{name: "reaching the threshold locks", maxFailedLogins: 3, failedBefore: 2, wantFailed: 0, wantLocked: true},
+		{name: "a threshold of one locks at once", maxFailedLogins: 1, failedBefore: 0, wantFailed: 0, wantLocked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := domain.NewLockoutPolicy().WithMaxFailedLogins(tt.maxFailedLogins)
+			cred := domain.NewCredential("alice", "hash").WithFailedLogins(tt.failedBefore)
+
+			got := policy.FailedLogin(cred, now)
+
+			if got.FailedLogins() != tt.wantFailed {
+				t.Errorf("got %d failed logins, want %d", got.FailedLogins(), tt.wantFailed)
+			}
+
+			if got.IsLocked(now) != tt.wantLocked {
+				t.Errorf("got locked %t, want %t", got.IsLocked(now), tt.wantLocked)
+			}
+		})
+	}
+}
+
+func TestLockoutPolicyDuration(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	policy := domain.NewLockoutPolicy().WithMaxFailedLogins(1).WithDuration(time.Minute)
+
+	cred := policy.FailedLogin(domain.NewCredential("alice", "hash"), now)
+
+	tests := []struct {
+		name   string
+		at     time.Time
+		locked bool
+	}{
+		{name: "at the failed login", at: now, locked: true},
+		{name: "just before the end", at: now.Add(time.Minute - time.Nanosecond), locked: true},
+		{name: "at the end", at: now.Add(time.Minute), locked: false},
+		{name: "after the end", at: now.Add(time.Hour), locked: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if cred.IsLocked(tt.at) != tt.locked {
+				t.Fatalf("got locked %t, want %t", cred.IsLocked(tt.at), tt.locked)
+			}
+		})
+	}
+
+	if !cred.LockedUntil().Equal(now.Add(time.Minute)) {
+		t.Fatalf("got locked until %v, want %v", cred.LockedUntil(), now.Add(time.Minute))
+	}
+}
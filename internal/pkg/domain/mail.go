@@ -0,0 +1,28 @@
+package domain
+
+// Mail is a plain text message to a single recipient.
+type Mail struct {
+	to      string
+	subject string
+	body    string
+}
+
+func NewMail(to, subject, body string) *Mail {
+	return &Mail{
+		to:      to,
+		subject: subject,
+		body:    body,
+	}
+}
+
+func (m *Mail) To() string {
+	return m.to
+}
+
+func (m *Mail) Subject() string {
+	return m.subject
+}
+
+func (m *Mail) Body() string {
+	return m.body
+}
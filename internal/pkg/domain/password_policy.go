@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// PasswordPolicy decides which passwords may be set.
+//
+// Following NIST SP 800-63B the default asks for a length and a minimum strength rather than
+// character classes, which only make users append "1!" to a weak password.
+type PasswordPolicy struct {
+	minLength        int
+	maxLength        int
+	maxBytes         int
+	characterClasses int
+	minStrength      int
+	allowUsername    bool
+}
+
+func NewPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		minLength:        8,   //nolint:mnd
+		maxLength:        128, //nolint:mnd
+		maxBytes:         0,
+		characterClasses: 0,
+		minStrength:      2, //nolint:mnd
+		allowUsername:    false,
+	}
+}
+
+func (p *PasswordPolicy) MinLength() int {
+	return p.minLength
+}
+
+func (p *PasswordPolicy) MaxLength() int {
+	return p.maxLength
+}
+
+// MaxBytes is the maximum length of a password in UTF-8 bytes, 0 if only MaxLength counts.
+func (p *PasswordPolicy) MaxBytes() int {
+	return p.maxBytes
+}
+
+func (p *PasswordPolicy) CharacterClasses() int {
+	return p.characterClasses
+}
+
+func (p *PasswordPolicy) MinStrength() int {
+	return p.minStrength
+}
+
+func (p *PasswordPolicy) AllowsUsername() bool {
+	return p.allowUsername
+}
+
+func (p *PasswordPolicy) WithLength(minLength, maxLength int) *PasswordPolicy {
+	ret := p.clone()
+	ret.minLength = minLength
+	ret.maxLength = maxLength
+
+	return ret
+}
+
+// WithMaxBytes limits the length of a password in UTF-8 bytes on top of MaxLength, e.g. for a hasher
+// that cannot hash longer ones. 0 removes the limit.
+func (p *PasswordPolicy) WithMaxBytes(maxBytes int) *PasswordPolicy {
+	ret := p.clone()
+	ret.maxBytes = maxBytes
+
+	return ret
+}
+
+// WithCharacterClasses requires characters of that many of the classes lowercase, uppercase, digit and other.
+func (p *PasswordPolicy) WithCharacterClasses(classes int) *PasswordPolicy {
+	ret := p.clone()
+	ret.characterClasses = classes
+
+	return ret
+}
+
+// WithMinStrength requires a PasswordStrength of at least strength, 0 disables the check.
+func (p *PasswordPolicy) WithMinStrength(strength int) *PasswordPolicy {
+	ret := p.clone()
+	ret.minStrength = strength
+
+	return ret
+}
+
+// WithUsernameAllowed decides whether the password may contain the username.
+func (p *PasswordPolicy) WithUsernameAllowed(allow bool) *PasswordPolicy {
+	ret := p.clone()
+	ret.allowUsername = allow
+
+	return ret
+}
+
+// Validate checks the password a user wants to set. The username is expected in its normalized form.
+// Returns:
+//   - ErrPasswordInvalid if the length or the character classes are not allowed
+//   - ErrPasswordContainsUsername if the password contains the username
+//   - ErrPasswordWeak if the password is too easy to guess
+func (p *PasswordPolicy) Validate(username, password string) error {
+	length := utf8.RuneCountInString(password)
+	if length < p.minLength || length > p.maxLength {
+		return fmt.Errorf("password must be %d to %d characters: %w", p.minLength, p.maxLength, ErrPasswordInvalid)
+	}
+
+	if p.maxBytes > 0 && len(password) > p.maxBytes {
+		return fmt.Errorf("password must be at most %d bytes: %w", p.maxBytes, ErrPasswordInvalid)
+	}
+
+	if characterClasses(password) < p.characterClasses {
+		return fmt.Errorf("password must mix %d of lowercase, uppercase, digits and other characters: %w",
+			p.characterClasses, ErrPasswordInvalid)
+	}
+
+	if !p.allowUsername && username != "" &&
+		strings.Contains(norm.NFKC.String(cases.Fold().String(password)), username) {
+		return ErrPasswordContainsUsername
+	}
+
+	strength := PasswordStrength(password)
+	if strength < p.minStrength {
+		return fmt.Errorf("password strength %d of 4 is below %d: %w", strength, p.minStrength, ErrPasswordWeak)
+	}
+
+	return nil
+}
+
+func (p *PasswordPolicy) clone() *PasswordPolicy {
+	ret := *p
+
+	return &ret
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, other int
+
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+
+	return lower + upper + digit + other
+}
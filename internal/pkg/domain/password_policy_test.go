@@ -0,0 +1,67 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	const strong = "zqxjvkwp"
+
+	tests := []struct {
+		name     string
+		policy   *domain.PasswordPolicy
+		username string
+		password string
+		want     error
+	}{
+		{name: "default accepts a strong password", policy: domain.NewPasswordPolicy(), username: "alice",
+			password: strong, want: nil},
+		{name: "one below the minimum length", policy: domain.NewPasswordPolicy(), username: "alice",
+			password: "zqxjvkw", want: domain.ErrPasswordInvalid},
+		{name: "at the maximum length", policy: domain.NewPasswordPolicy().WithLength(8, 10), username: "alice",
+			password: "zqxjvkwpmb", want: nil},
+		{name: "one above the maximum length", policy: domain.NewPasswordPolicy().WithLength(8, 10),
+			username: "alice", password: "zqxjvkwpmbf", want: domain.ErrPasswordInvalid},
+		{name: "length counts characters, not bytes", policy: domain.NewPasswordPolicy().WithLength(8, 8),
+			username: "alice", password: "zqxjvkwé", want: nil},
+		{name: "at the byte limit", policy: domain.NewPasswordPolicy().WithMaxBytes(72), username: "alice",
+			password: strings.Repeat("€", 24), want: nil},
+		{name: "above the byte limit", policy: domain.NewPasswordPolicy().WithMaxBytes(72), username: "alice",
+			password: strings.Repeat("€", 25), want: domain.ErrPasswordInvalid},
+		{name: "too few character classes", policy: domain.NewPasswordPolicy().WithCharacterClasses(3),
+			username: "alice", password: "zqxjVKWP", want: domain.ErrPasswordInvalid},
+		{name: "enough character classes", policy: domain.NewPasswordPolicy().WithCharacterClasses(3),
+			username: "alice", password: "zqxjVKW9", want: nil},
+		{name: "contains the username", policy: domain.NewPasswordPolicy(), username: "alice",
+			password: "zq-alice-xj", want: domain.ErrPasswordContainsUsername},
+		{name: "contains the username in other case", policy: domain.NewPasswordPolicy(), username: "alice",
+			password: "zq-ALICE-xj", want: domain.ErrPasswordContainsUsername},
+		{name: "contains the username in full width", policy: domain.NewPasswordPolicy(), username: "alice",
+			password: "zq-ＡＬＩＣＥ-xj", want: domain.ErrPasswordContainsUsername},
+		{name: "username allowed", policy: domain.NewPasswordPolicy().WithUsernameAllowed(true), username: "alice",
+			password: "zq-alice-xj", want: nil},
+		{name: "weak", policy: domain.NewPasswordPolicy(), username: "alice", password: "aaaaaaaaaaaa",
+			want: domain.ErrPasswordWeak},
+		{name: "common", policy: domain.NewPasswordPolicy(), username: "alice", password: "Password1!",
+			want: domain.ErrPasswordWeak},
+		{name: "strength check disabled", policy: domain.NewPasswordPolicy().WithMinStrength(0), username: "alice",
+			password: "aaaaaaaaaaaa", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.policy.Validate(tt.username, tt.password)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// commonPasswords are among the most used passwords, which are guessed first.
+var commonPasswords = []string{ //nolint:gochecknoglobals
+	"123456", "12345678", "123456789", "1234567890", "password", "qwerty", "qwertyuiop", "111111", "000000",
+	"abc123", "iloveyou", "admin", "welcome", "monkey", "dragon", "letmein", "football", "baseball",
+	"sunshine", "princess", "master", "shadow", "superman", "trustno1", "passw0rd", "p@ssw0rd", "p@ssword",
+	"starwars", "whatever", "freedom", "hello", "charlie", "batman", "michael", "jennifer", "secret",
+	"computer", "internet", "changeme", "default", "login", "access", "summer", "winter", "spring", "autumn",
+}
+
+// keyboardRows are the letter rows of a QWERTY keyboard, walking along them is as predictable as a sequence.
+var keyboardRows = []string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm"} //nolint:gochecknoglobals
+
+// PasswordStrength estimates how hard a password is to guess, on the scale of zxcvbn from 0 (guessed at once)
+// to 4 (very unguessable). Common passwords, even with digits or a '!' appended, score 0. Otherwise
+// every character adds the guesses of its character set, except for repeats, sequences such as "abc" and
+// keyboard walks such as "qwe", which add next to nothing.
+func PasswordStrength(password string) int {
+	folded := strings.ToLower(password)
+	if slices.Contains(commonPasswords, strings.TrimRight(folded, "0123456789!")) {
+		return 0
+	}
+
+	var (
+		effective float64
+		previous  rune
+	)
+
+	for i, r := range []rune(folded) {
+		if i > 0 && predictable(previous, r) {
+			effective += 0.25 //nolint:mnd
+		} else {
+			effective++
+		}
+
+		previous = r
+	}
+
+	guesses := effective * math.Log10(charsetSize(password))
+
+	switch {
+	case guesses < 3: //nolint:mnd
+		return 0
+	case guesses < 6: //nolint:mnd
+		return 1
+	case guesses < 8: //nolint:mnd
+		return 2 //nolint:mnd
+	case guesses < 10: //nolint:mnd
+		return 3 //nolint:mnd
+	default:
+		return 4 //nolint:mnd
+	}
+}
+
+// predictable reports whether r repeats, continues a sequence or walks the keyboard from previous.
+func predictable(previous, r rune) bool {
+	if r == previous || r == previous+1 || r == previous-1 {
+		return true
+	}
+
+	for _, row := range keyboardRows {
+		i := strings.IndexRune(row, previous)
+		j := strings.IndexRune(row, r)
+
+		if i >= 0 && j >= 0 && (i-j == 1 || j-i == 1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// the sizes of the character sets a password draws from.
+const (
+	lowerSize  = 26
+	upperSize  = 26
+	digitSize  = 10
+	symbolSize = 33
+	otherSize  = 100
+)
+
+func charsetSize(password string) float64 {
+	var lower, upper, digit, symbol, other float64
+
+	for _, r := range password {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = lowerSize
+		case r >= 'A' && r <= 'Z':
+			upper = upperSize
+		case r >= '0' && r <= '9':
+			digit = digitSize
+		case r < unicode.MaxASCII:
+			symbol = symbolSize
+		default:
+			other = otherSize
+		}
+	}
+
+	return max(lower+upper+digit+symbol+other, 1)
+}
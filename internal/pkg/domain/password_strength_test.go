@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+func TestPasswordStrength(t *testing.T) {
+	t.Parallel()
+
+	// "zqxjvkwp" has no repeats, sequences or keyboard walks, so each lowercase letter adds log10(26) ≈ 1.41
+	tests := []struct {
+		password string
+		want     int
+	}{
+		{password: "", want: 0},
+		{password: "zq", want: 0},           // 2.8 below 3
+		{password: "zqx", want: 1},          // 4.2
+		{password: "zqxj", want: 1},         // 5.7 below 6
+		{password: "zqxjv", want: 2},        // 7.1
+		{password: "zqxjvk", want: 3},       // 8.5
+		{password: "zqxjvkw", want: 3},      // 9.9 below 10
+		{password: "zqxjvkwp", want: 4},     // 11.3
+		{password: "ZqXjVk", want: 4},       // mixed case draws from 52 characters, 10.3
+		{password: "aaaaaaaaaaaa", want: 1}, // repeats add a quarter each, 5.3
+		{password: "abcdefghijkl", want: 1}, // so do sequences
+		{password: "asdfghjkl", want: 1},    // and keyboard walks, 4.2
+		{password: "password", want: 0},
+		{password: "Password1!", want: 0}, // a common password with digits and a '!' appended
+		{password: "letmein2024", want: 0},
+		{password: "correct horse battery staple", want: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			t.Parallel()
+
+			got := domain.PasswordStrength(tt.password)
+			if got != tt.want {
+				t.Fatalf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
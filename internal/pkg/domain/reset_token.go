@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// ResetToken lets the user who received it by mail set a new password once.
+// Only a hash of the secret sent to the user is kept, the ID, so a leaked repository cannot reset passwords.
+type ResetToken struct {
+	id        string
+	username  string
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+func NewResetToken(id, username string, createdAt, expiresAt time.Time) *ResetToken {
+	return &ResetToken{
+		id:        id,
+		username:  username,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+	}
+}
+
+func (t *ResetToken) ID() string {
+	return t.id
+}
+
+func (t *ResetToken) Username() string {
+	return t.username
+}
+
+func (t *ResetToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *ResetToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *ResetToken) IsExpired(now time.Time) bool {
+	return now.After(t.expiresAt)
+}
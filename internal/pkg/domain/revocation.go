@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Revocation denies a token by its ID until the token would have expired anyway.
+type Revocation struct {
+	tokenID   string
+	expiresAt time.Time
+}
+
+func NewRevocation(tokenID string, expiresAt time.Time) *Revocation {
+	return &Revocation{
+		tokenID:   tokenID,
+		expiresAt: expiresAt,
+	}
+}
+
+func (r *Revocation) TokenID() string {
+	return r.tokenID
+}
+
+func (r *Revocation) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+// IsExpired reports whether the revoked token has expired, after which the revocation can be forgotten.
+func (r *Revocation) IsExpired(now time.Time) bool {
+	return now.After(r.expiresAt)
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// Session is a family of refresh tokens. Only the latest refresh token of the
+// family is valid, presenting an older one means the family was stolen.
+type Session struct {
+	id             string
+	username       string
+	clientID       string
+	refreshTokenID string
+	audience       []string
+	scope          []string
+	createdAt      time.Time
+	expiresAt      time.Time
+}
+
+// NewSession returns a session of the user. The client ID is empty if the user logged in without a client.
+func NewSession(id, username, clientID, refreshTokenID string, createdAt, expiresAt time.Time) *Session {
+	return &Session{
+		id:             id,
+		username:       username,
+		clientID:       clientID,
+		refreshTokenID: refreshTokenID,
+		audience:       nil,
+		scope:          nil,
+		createdAt:      createdAt,
+		expiresAt:      expiresAt,
+	}
+}
+
+func (s *Session) ID() string {
+	return s.id
+}
+
+func (s *Session) Username() string {
+	return s.username
+}
+
+func (s *Session) ClientID() string {
+	return s.clientID
+}
+
+func (s *Session) RefreshTokenID() string {
+	return s.refreshTokenID
+}
+
+// Audience returns the audience the tokens of the session were requested for.
+func (s *Session) Audience() []string {
+	return slices.Clone(s.audience)
+}
+
+// Scope returns the scope granted to the tokens of the session.
+func (s *Session) Scope() []string {
+	return slices.Clone(s.scope)
+}
+
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s *Session) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+// IsExpired reports whether the latest refresh token of the session has expired,
+// after which the session can be deleted.
+func (s *Session) IsExpired(now time.Time) bool {
+	return now.After(s.expiresAt)
+}
+
+// WithGrant returns the session with the audience and the scope of its tokens.
+func (s *Session) WithGrant(audience, scope []string) *Session {
+	ret := s.clone()
+	ret.audience = slices.Clone(audience)
+	ret.scope = slices.Clone(scope)
+
+	return ret
+}
+
+// Rotate returns the session with refreshTokenID as its latest refresh token.
+func (s *Session) Rotate(refreshTokenID string, expiresAt time.Time) *Session {
+	ret := s.clone()
+	ret.refreshTokenID = refreshTokenID
+	ret.expiresAt = expiresAt
+
+	return ret
+}
+
+func (s *Session) clone() *Session {
+	ret := *s
+	ret.audience = slices.Clone(s.audience)
+	ret.scope = slices.Clone(s.scope)
+
+	return &ret
+}
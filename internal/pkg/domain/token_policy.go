@@ -2,15 +2,25 @@ package domain
 
 import "time"
 
+// TokenPolicy decides how long the tokens of a session live.
+//
+// With a sliding refresh window every refresh moves the expiry of the refresh token to a full
+// refresh token duration from now, otherwise the refresh token expires a refresh token duration
+// after the session was created. A session lifetime caps every token of the session, no matter
+// how often it is refreshed. A zero session lifetime means sessions live as long as they are refreshed.
 type TokenPolicy struct {
 	accessTokenDuration  time.Duration
 	refreshTokenDuration time.Duration
+	sessionLifetime      time.Duration
+	slidingRefresh       bool
 }
 
 func NewTokenPolicy() *TokenPolicy {
 	return &TokenPolicy{
 		accessTokenDuration:  15 * time.Minute, //nolint:mnd
 		refreshTokenDuration: 14 * 24 * time.Hour,
+		sessionLifetime:      0,
+		slidingRefresh:       true,
 	}
 }
 
@@ -21,3 +31,66 @@ func (t *TokenPolicy) AccessTokenDuration() time.Duration {
 func (t *TokenPolicy) RefreshTokenDuration() time.Duration {
 	return t.refreshTokenDuration
 }
+
+func (t *TokenPolicy) SessionLifetime() time.Duration {
+	return t.sessionLifetime
+}
+
+func (t *TokenPolicy) SlidingRefresh() bool {
+	return t.slidingRefresh
+}
+
+func (t *TokenPolicy) WithAccessTokenDuration(duration time.Duration) *TokenPolicy {
+	ret := *t
+	ret.accessTokenDuration = duration
+
+	return &ret
+}
+
+func (t *TokenPolicy) WithRefreshTokenDuration(duration time.Duration) *TokenPolicy {
+	ret := *t
+	ret.refreshTokenDuration = duration
+
+	return &ret
+}
+
+func (t *TokenPolicy) WithSessionLifetime(lifetime time.Duration) *TokenPolicy {
+	ret := *t
+	ret.sessionLifetime = lifetime
+
+	return &ret
+}
+
+func (t *TokenPolicy) WithSlidingRefresh(sliding bool) *TokenPolicy {
+	ret := *t
+	ret.slidingRefresh = sliding
+
+	return &ret
+}
+
+// AccessTokenExpiresAt returns the expiry of an access token issued at now in a session created at createdAt.
+func (t *TokenPolicy) AccessTokenExpiresAt(createdAt, now time.Time) time.Time {
+	return t.capped(createdAt, now.Add(t.accessTokenDuration))
+}
+
+// RefreshTokenExpiresAt returns the expiry of a refresh token issued at now in a session created at createdAt.
+func (t *TokenPolicy) RefreshTokenExpiresAt(createdAt, now time.Time) time.Time {
+	if t.slidingRefresh {
+		return t.capped(createdAt, now.Add(t.refreshTokenDuration))
+	}
+
+	return t.capped(createdAt, createdAt.Add(t.refreshTokenDuration))
+}
+
+func (t *TokenPolicy) capped(createdAt, expiresAt time.Time) time.Time {
+	if t.sessionLifetime <= 0 {
+		return expiresAt
+	}
+
+	limit := createdAt.Add(t.sessionLifetime)
+	if expiresAt.After(limit) {
+		return limit
+	}
+
+	return expiresAt
+}
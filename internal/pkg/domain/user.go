@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"maps"
+	"time"
+)
+
+// User is the profile of a user, kept next to the credential of the same username.
+// The repository sets the timestamps: createdAt when the credential is created, updatedAt whenever
+// the credential or the profile changes.
+type User struct {
+	username    string
+	displayName string
+	locale      string
+	attributes  map[string]string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+func NewUser(username string, createdAt, updatedAt time.Time) *User {
+	return &User{
+		username:    username,
+		displayName: "",
+		locale:      "",
+		attributes:  nil,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+func (u *User) Username() string {
+	return u.username
+}
+
+// DisplayName is the name the user is shown as, empty if the user has none.
+func (u *User) DisplayName() string {
+	return u.displayName
+}
+
+// Locale is the BCP 47 language tag the user prefers, empty if the user has none.
+func (u *User) Locale() string {
+	return u.locale
+}
+
+// Attributes are free key/value pairs of the applications. The returned map is a copy.
+func (u *User) Attributes() map[string]string {
+	return maps.Clone(u.attributes)
+}
+
+// CreatedAt is zero for users created before profiles were kept.
+func (u *User) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+func (u *User) UpdatedAt() time.Time {
+	return u.updatedAt
+}
+
+func (u *User) WithDisplayName(displayName string) *User {
+	ret := u.clone()
+	ret.displayName = displayName
+
+	return ret
+}
+
+func (u *User) WithLocale(locale string) *User {
+	ret := u.clone()
+	ret.locale = locale
+
+	return ret
+}
+
+// WithAttributes replaces every attribute.
+func (u *User) WithAttributes(attributes map[string]string) *User {
+	ret := u.clone()
+	ret.attributes = maps.Clone(attributes)
+
+	return ret
+}
+
+func (u *User) clone() *User {
+	ret := *u
+
+	return &ret
+}
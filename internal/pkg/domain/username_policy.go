@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// UsernamePolicy decides which usernames may be registered.
+//
+// Usernames are normalized before they are validated or looked up: NFKC turns look-alike forms such as
+// full width letters into their plain form and case folding makes "Alice" and "alice" the same user.
+// A valid username consists of letters, digits, '.', '_' and '-' and starts with a letter or a digit,
+// so it can never be a path such as "../x".
+type UsernamePolicy struct {
+	minLength int
+	maxLength int
+	reserved  []string
+}
+
+func NewUsernamePolicy() *UsernamePolicy {
+	return &UsernamePolicy{
+		minLength: 3,  //nolint:mnd
+		maxLength: 32, //nolint:mnd
+		reserved:  []string{"admin", "administrator", "root", "system", "key-stone", "me", "null", "anonymous"},
+	}
+}
+
+func (p *UsernamePolicy) MinLength() int {
+	return p.minLength
+}
+
+func (p *UsernamePolicy) MaxLength() int {
+	return p.maxLength
+}
+
+func (p *UsernamePolicy) Reserved() []string {
+	return slices.Clone(p.reserved)
+}
+
+func (p *UsernamePolicy) WithLength(minLength, maxLength int) *UsernamePolicy {
+	ret := p.clone()
+	ret.minLength = minLength
+	ret.maxLength = maxLength
+
+	return ret
+}
+
+// WithReserved replaces the reserved usernames. They are normalized like usernames.
+func (p *UsernamePolicy) WithReserved(reserved ...string) *UsernamePolicy {
+	ret := p.clone()
+	ret.reserved = nil
+
+	for _, name := range reserved {
+		ret.reserved = append(ret.reserved, p.Normalize(name))
+	}
+
+	return ret
+}
+
+// Normalize returns the canonical form of a username, under which it is stored and looked up.
+func (p *UsernamePolicy) Normalize(username string) string {
+	return norm.NFKC.String(cases.Fold().String(norm.NFKC.String(username)))
+}
+
+// Validate returns the canonical form of a username that may be registered.
+// Returns:
+//   - ErrUsernameInvalid if the length or a character is not allowed
+//   - ErrUsernameReserved if the username is reserved
+func (p *UsernamePolicy) Validate(username string) (string, error) {
+	normalized := p.Normalize(username)
+
+	length := utf8.RuneCountInString(normalized)
+	if length < p.minLength || length > p.maxLength {
+		return "", fmt.Errorf("username must be %d to %d characters: %w", p.minLength, p.maxLength, ErrUsernameInvalid)
+	}
+
+	for i, r := range normalized {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r):
+		case i > 0 && (r == '.' || r == '_' || r == '-'):
+		default:
+			return "", fmt.Errorf("username must not contain %q: %w", r, ErrUsernameInvalid)
+		}
+	}
+
+	if slices.Contains(p.reserved, normalized) {
+		return "", fmt.Errorf("username %q: %w", normalized, ErrUsernameReserved)
+	}
+
+	return normalized, nil
+}
+
+func (p *UsernamePolicy) clone() *UsernamePolicy {
+	ret := *p
+	ret.reserved = slices.Clone(p.reserved)
+
+	return &ret
+}
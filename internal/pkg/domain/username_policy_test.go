@@ -0,0 +1,127 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+func TestUsernamePolicyNormalizeCollisions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		left  string
+		right string
+	}{
+		{name: "case", left: "Alice", right: "alice"},
+		{name: "full width letters", left: "ＡＬＩＣＥ", right: "alice"},
+		{name: "full width digits", left: "bob１２", right: "bob12"},
+		{name: "kelvin sign", left: "\u212Aate", right: "kate"},
+		{name: "ligature", left: "ﬁnn", right: "finn"},
+		{name: "sharp s", left: "Straße", right: "STRASSE"},
+		{name: "final sigma", left: "σας", right: "ΣΑΣ"},
+		{name: "superscript digit", left: "ab²", right: "ab2"},
+		{name: "composed and decomposed accent", left: "jos\u00e9", right: "jose\u0301"},
+	}
+
+	policy := domain.NewUsernamePolicy()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			left, right := policy.Normalize(tt.left), policy.Normalize(tt.right)
+			if left != right {
+				t.Fatalf("%q normalizes to %q, %q to %q", tt.left, left, tt.right, right)
+			}
+
+			// normalizing is idempotent, so stored names are found again
+			if again := policy.Normalize(left); again != left {
+				t.Fatalf("got %q, want %q", again, left)
+			}
+		})
+	}
+}
+
+func TestUsernamePolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		policy   *domain.UsernamePolicy
+		username string
+		want     string
+		err      error
+	}{
+		{name: "plain", policy: domain.NewUsernamePolicy(), username: "alice", want: "alice", err: nil},
+		{name: "returns the normalized name", policy: domain.NewUsernamePolicy(), username: "ＡＬＩＣＥ",
+			want: "alice", err: nil},
+		{name: "punctuation inside", policy: domain.NewUsernamePolicy(), username: "a.b_c-d", want: "a.b_c-d",
+			err: nil},
+		{name: "other scripts", policy: domain.NewUsernamePolicy(), username: "Ἀλέξανδρος",
+			want: "ἀλέξανδροσ", err: nil},
+		{name: "decimal digits of other scripts", policy: domain.NewUsernamePolicy(), username: "user١٢٣",
+			want: "user١٢٣", err: nil},
+		{name: "starts with a digit", policy: domain.NewUsernamePolicy(), username: "1abc", want: "1abc", err: nil},
+		{name: "numbers that are not decimal digits", policy: domain.NewUsernamePolicy(), username: "abc\U00010107",
+			want: "", err: domain.ErrUsernameInvalid},
+		{name: "starts with a dot", policy: domain.NewUsernamePolicy(), username: ".abc", want: "",
+			err: domain.ErrUsernameInvalid},
+		{name: "starts with a dash", policy: domain.NewUsernamePolicy(), username: "-abc", want: "",
+			err: domain.ErrUsernameInvalid},
+		{name: "starts with an underscore", policy: domain.NewUsernamePolicy(), username: "_abc", want: "",
+			err: domain.ErrUsernameInvalid},
+		{name: "path", policy: domain.NewUsernamePolicy(), username: "../x", want: "",
+			err: domain.ErrUsernameInvalid},
+		{name: "full width dots and slash", policy: domain.NewUsernamePolicy(), username: "．．／x", want: "",
+			err: domain.ErrUsernameInvalid},
+		{name: "slash", policy: domain.NewUsernamePolicy(), username: "a/b", want: "",
+			err: domain.ErrUsernameInvalid},
+		{name: "space", policy: domain.NewUsernamePolicy(), username: "a b", want: "",
+			err: domain.ErrUsernameInvalid},
+		{name: "at sign", policy: domain.NewUsernamePolicy(), username: "a@b.c", want: "",
+			err: domain.ErrUsernameInvalid},
+		{name: "below the minimum length", policy: domain.NewUsernamePolicy(), username: "ab", want: "",
+			err: domain.ErrUsernameInvalid},
+		{name: "at the minimum length", policy: domain.NewUsernamePolicy(), username: "abc", want: "abc",
+			err: nil},
+		{name: "at the maximum length", policy: domain.NewUsernamePolicy(), username: strings.Repeat("a", 32),
+			want: strings.Repeat("a", 32), err: nil},
+		{name: "above the maximum length", policy: domain.NewUsernamePolicy(), username: strings.Repeat("a", 33),
+			want: "", err: domain.ErrUsernameInvalid},
+		{name: "length counts characters, not bytes", policy: domain.NewUsernamePolicy().WithLength(3, 3),
+			username: "éèê", want: "éèê", err: nil},
+		{name: "length counts the normalized name", policy: domain.NewUsernamePolicy(), username: "ﬁx",
+			want: "fix", err: nil},
+		{name: "other lengths", policy: domain.NewUsernamePolicy().WithLength(1, 4), username: "abcde",
+			want: "", err: domain.ErrUsernameInvalid},
+		{name: "reserved", policy: domain.NewUsernamePolicy(), username: "admin", want: "",
+			err: domain.ErrUsernameReserved},
+		{name: "reserved in other case", policy: domain.NewUsernamePolicy(), username: "Root", want: "",
+			err: domain.ErrUsernameReserved},
+		{name: "reserved in full width", policy: domain.NewUsernamePolicy(), username: "ＡＤＭＩＮ", want: "",
+			err: domain.ErrUsernameReserved},
+		{name: "replaced reserved names", policy: domain.NewUsernamePolicy().WithReserved("Staff"),
+			username: "STAFF", want: "", err: domain.ErrUsernameReserved},
+		{name: "no longer reserved", policy: domain.NewUsernamePolicy().WithReserved("staff"), username: "admin",
+			want: "admin", err: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.policy.Validate(tt.username)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// VerificationToken proves that the user who received it by mail owns the email it was sent to.
+// Like a ResetToken only a hash of the secret is kept as the ID.
+type VerificationToken struct {
+	id        string
+	username  string
+	email     string
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+func NewVerificationToken(id, username, email string, createdAt, expiresAt time.Time) *VerificationToken {
+	return &VerificationToken{
+		id:        id,
+		username:  username,
+		email:     email,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+	}
+}
+
+func (t *VerificationToken) ID() string {
+	return t.id
+}
+
+func (t *VerificationToken) Username() string {
+	return t.username
+}
+
+// Email is the address the token was sent to. The token verifies nothing once the user has another one.
+func (t *VerificationToken) Email() string {
+	return t.email
+}
+
+func (t *VerificationToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *VerificationToken) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *VerificationToken) IsExpired(now time.Time) bool {
+	return now.After(t.expiresAt)
+}
@@ -0,0 +1,50 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/grantprovider"
+)
+
+var _ grantprovider.Provider = (*Provider)(nil)
+
+// Provider reads grants from a JSON file mapping usernames to their grant, e.g.
+//
+//	{"alice": {"audience": ["billing"], "scope": ["invoices:read"]}}
+//
+// Users missing from the file are granted nothing.
+type Provider struct {
+	grants map[string]record
+}
+
+type record struct {
+	Audience []string `json:"audience"`
+	Scope    []string `json:"scope"`
+}
+
+func NewProvider(path string) (*Provider, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read grants file: %w", err)
+	}
+
+	var grants map[string]record
+
+	err = json.Unmarshal(data, &grants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grants file: %w", err)
+	}
+
+	return &Provider{grants: grants}, nil
+}
+
+func (p *Provider) GetGrant(ctx context.Context, username string) (*domain.Grant, error) {
+	grant := p.grants[username]
+
+	return domain.NewGrant(grant.Audience, grant.Scope), nil
+}
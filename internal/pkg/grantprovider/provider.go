@@ -0,0 +1,12 @@
+package grantprovider
+
+import (
+	"context"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+// Provider supplies the audiences and scopes a user may request tokens for.
+type Provider interface {
+	GetGrant(ctx context.Context, username string) (*domain.Grant, error)
+}
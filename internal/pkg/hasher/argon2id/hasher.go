@@ -0,0 +1,170 @@
+package argon2id
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+	"golang.org/x/crypto/argon2"
+)
+
+var _ hasher.Hasher = (*Hasher)(nil)
+
+const prefix = "$argon2id$"
+
+// maxMemory bounds the memory a stored hash may ask for, in KiB. It is well above the recommended settings,
+// e.g. the 64 MiB of RFC 9106, but keeps a crafted or imported hash from exhausting the memory at login.
+const maxMemory = 256 * 1024
+
+// Params are the cost parameters of argon2id.
+type Params struct {
+	Memory      uint32 // in KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultParams returns the parameters recommended by OWASP: 19 MiB of memory, 2 iterations and 1 lane.
+func DefaultParams() Params {
+	return Params{
+		Memory:      19 * 1024, //nolint:mnd
+		Iterations:  2,         //nolint:mnd
+		Parallelism: 1,
+		SaltLength:  16, //nolint:mnd
+		KeyLength:   32, //nolint:mnd
+	}
+}
+
+// Hasher hashes passwords with argon2id into PHC strings, e.g.
+//
+//	$argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>
+//
+// with salt and key in unpadded standard base64.
+type Hasher struct {
+	params Params
+}
+
+func NewHasher(params Params) *Hasher {
+	return &Hasher{params: params}
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism,
+		h.params.KeyLength)
+
+	return encode(h.params, salt, key), nil
+}
+
+func (h *Hasher) Compare(password, hash string) error {
+	params, salt, key, err := decode(hash)
+	if err != nil {
+		return err
+	}
+
+	actual := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism,
+		params.KeyLength)
+	if subtle.ConstantTimeCompare(actual, key) != 1 {
+		return hasher.ErrMismatched
+	}
+
+	return nil
+}
+
+// Recognizes reports whether hash is a well-formed argon2id hash with parameters Compare accepts.
+func (h *Hasher) Recognizes(hash string) bool {
+	_, _, _, err := decode(hash)
+
+	return err == nil
+}
+
+func (h *Hasher) NeedsRehash(hash string) bool {
+	params, _, _, err := decode(hash)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory < h.params.Memory ||
+		params.Iterations < h.params.Iterations ||
+		params.Parallelism < h.params.Parallelism ||
+		params.SaltLength < h.params.SaltLength ||
+		params.KeyLength < h.params.KeyLength
+}
+
+func encode(params Params, salt, key []byte) string {
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", prefix, argon2.Version,
+		params.Memory, params.Iterations, params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+func decode(hash string) (Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	const fields = 6
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != fields || parts[1] != "argon2id" {
+		return Params{}, nil, nil, fmt.Errorf("not an argon2id hash: %w", hasher.ErrInvalidHash)
+	}
+
+	var version int
+
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return Params{}, nil, nil, fmt.Errorf("unsupported version %q: %w", parts[2], hasher.ErrInvalidHash)
+	}
+
+	var params Params
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism)
+	if err != nil {
+		return Params{}, nil, nil, fmt.Errorf("invalid parameters %q: %w", parts[3], hasher.ErrInvalidHash)
+	}
+
+	err = validate(params)
+	if err != nil {
+		return Params{}, nil, nil, fmt.Errorf("invalid parameters %q: %w", parts[3], err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return Params{}, nil, nil, fmt.Errorf("invalid salt: %w", hasher.ErrInvalidHash)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return Params{}, nil, nil, fmt.Errorf("invalid key: %w", hasher.ErrInvalidHash)
+	}
+
+	params.SaltLength = uint32(len(salt)) //nolint:gosec
+	params.KeyLength = uint32(len(key))   //nolint:gosec
+
+	return params, salt, key, nil
+}
+
+// validate rejects the parameters argon2.IDKey panics on and a memory above maxMemory.
+func validate(params Params) error {
+	const blocksPerLane = 8 // argon2 needs at least 8 KiB of memory per lane
+
+	switch {
+	case params.Iterations < 1:
+		return fmt.Errorf("no iterations: %w", hasher.ErrInvalidHash)
+	case params.Parallelism < 1:
+		return fmt.Errorf("no lanes: %w", hasher.ErrInvalidHash)
+	case params.Memory < blocksPerLane*uint32(params.Parallelism):
+		return fmt.Errorf("less than %d KiB of memory per lane: %w", blocksPerLane, hasher.ErrInvalidHash)
+	case params.Memory > maxMemory:
+		return fmt.Errorf("more than %d KiB of memory: %w", maxMemory, hasher.ErrInvalidHash)
+	default:
+		return nil
+	}
+}
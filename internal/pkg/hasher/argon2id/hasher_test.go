@@ -0,0 +1,168 @@
+package argon2id_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/argon2id"
+)
+
+// cheapParams keep the tests fast, they are far below DefaultParams.
+func cheapParams() argon2id.Params {
+	return argon2id.Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+}
+
+func TestMalformedHashes(t *testing.T) {
+	t.Parallel()
+
+	// "c2FsdHNhbHRzYWx0c2FsdA" is a 16 byte salt and "a2V5" a 3 byte key, both valid base64
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "zero iterations", hash: "$argon2id$v=19$m=64,t=0,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{name: "zero lanes", hash: "$argon2id$v=19$m=64,t=1,p=0$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{name: "less memory than 8 KiB per lane", hash: "$argon2id$v=19$m=15,t=1,p=2$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{name: "memory above the limit", hash: "$argon2id$v=19$m=4194304,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{name: "empty salt", hash: "$argon2id$v=19$m=64,t=1,p=1$$a2V5"},
+		{name: "empty key", hash: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$"},
+		{name: "negative memory", hash: "$argon2id$v=19$m=-1,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{name: "other version", hash: "$argon2id$v=16$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{name: "argon2i", hash: "$argon2i$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{name: "missing key", hash: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA"},
+		{name: "prefix only", hash: "$argon2id$"},
+	}
+
+	h := argon2id.NewHasher(cheapParams())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if h.Recognizes(tt.hash) {
+				t.Fatalf("recognized %q", tt.hash)
+			}
+
+			// a panic in argon2.IDKey fails the test here
+			err := h.Compare("password", tt.hash)
+			if !errors.Is(err, hasher.ErrInvalidHash) {
+				t.Fatalf("got %v, want %v", err, hasher.ErrInvalidHash)
+			}
+
+			if !h.NeedsRehash(tt.hash) {
+				t.Fatalf("malformed hash %q does not need a rehash", tt.hash)
+			}
+		})
+	}
+}
+
+func TestBoundaryParameters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "8 KiB per lane", hash: "$argon2id$v=19$m=16,t=1,p=2$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+		{name: "memory at the limit", hash: "$argon2id$v=19$m=262144,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5"},
+	}
+
+	h := argon2id.NewHasher(cheapParams())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if !h.Recognizes(tt.hash) {
+				t.Fatalf("did not recognize %q", tt.hash)
+			}
+		})
+	}
+}
+
+func TestHashAndCompare(t *testing.T) {
+	t.Parallel()
+
+	h := argon2id.NewHasher(cheapParams())
+
+	hash, err := h.Hash("Correct-Horse-9!")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !h.Recognizes(hash) {
+		t.Fatalf("did not recognize its own hash %q", hash)
+	}
+
+	err = h.Compare("Correct-Horse-9!", hash)
+	if err != nil {
+		t.Fatalf("got %v for the right password", err)
+	}
+
+	err = h.Compare("correct-horse-9!", hash)
+	if !errors.Is(err, hasher.ErrMismatched) {
+		t.Fatalf("got %v, want %v", err, hasher.ErrMismatched)
+	}
+
+	again, err := h.Hash("Correct-Horse-9!")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if again == hash {
+		t.Fatal("two hashes of the same password are equal, the salt is not random")
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	current := cheapParams()
+
+	tests := []struct {
+		name   string
+		stored argon2id.Params
+		want   bool
+	}{
+		{name: "same parameters", stored: current, want: false},
+		{name: "less memory", stored: argon2id.Params{Memory: 32, Iterations: 1, Parallelism: 1, SaltLength: 16,
+			KeyLength: 32}, want: true},
+		{name: "more memory", stored: argon2id.Params{Memory: 128, Iterations: 1, Parallelism: 1, SaltLength: 16,
+			KeyLength: 32}, want: false},
+		{name: "more iterations", stored: argon2id.Params{Memory: 64, Iterations: 2, Parallelism: 1, SaltLength: 16,
+			KeyLength: 32}, want: false},
+		{name: "shorter salt", stored: argon2id.Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 8,
+			KeyLength: 32}, want: true},
+		{name: "shorter key", stored: argon2id.Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16,
+			KeyLength: 16}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hash, err := argon2id.NewHasher(tt.stored).Hash("password")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := argon2id.NewHasher(current).NeedsRehash(hash)
+			if got != tt.want {
+				t.Fatalf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+
+	// stronger than the current parameters in one way does not excuse weaker ones in another
+	stronger := argon2id.Params{Memory: 64, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+	hash, err := argon2id.NewHasher(current).Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !argon2id.NewHasher(stronger).NeedsRehash(hash) {
+		t.Fatal("a hash with fewer iterations than the hasher does not need a rehash")
+	}
+}
@@ -3,6 +3,7 @@ package bcrypt
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
 	"golang.org/x/crypto/bcrypt"
@@ -10,14 +11,26 @@ import (
 
 var _ hasher.Hasher = (*Hasher)(nil)
 
-type Hasher struct{}
+// MaxPasswordBytes is the length of the longest password bcrypt hashes.
+const MaxPasswordBytes = 72
+
+// Hasher hashes passwords with bcrypt. Bcrypt rejects passwords longer than MaxPasswordBytes,
+// prefer argon2id for new hashes.
+type Hasher struct {
+	cost int
+}
 
 func NewHasher() *Hasher {
-	return &Hasher{}
+	return &Hasher{cost: bcrypt.DefaultCost}
+}
+
+// WithCost returns a hasher using cost for new hashes. Hashes of a lower cost need a rehash.
+func (h *Hasher) WithCost(cost int) *Hasher {
+	return &Hasher{cost: cost}
 }
 
 func (h *Hasher) Hash(password string) (string, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
 	if err != nil {
 		return "", fmt.Errorf("failed to hash password: %w", err)
 	}
@@ -25,7 +38,13 @@ func (h *Hasher) Hash(password string) (string, error) {
 	return string(hashedPassword), nil
 }
 
+// Compare rejects passwords longer than MaxPasswordBytes. bcrypt.CompareHashAndPassword would ignore
+// the bytes after the 72nd, so any suffix of a long password would match.
 func (h *Hasher) Compare(password, hash string) error {
+	if len(password) > MaxPasswordBytes {
+		return hasher.ErrMismatched
+	}
+
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	if err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
@@ -37,3 +56,19 @@ func (h *Hasher) Compare(password, hash string) error {
 
 	return nil
 }
+
+func (h *Hasher) Recognizes(hash string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *Hasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+
+	return err != nil || cost < h.cost
+}
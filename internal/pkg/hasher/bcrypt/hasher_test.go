@@ -0,0 +1,68 @@
+package bcrypt_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/bcrypt"
+	gobcrypt "golang.org/x/crypto/bcrypt"
+)
+
+func TestHashAndCompare(t *testing.T) {
+	t.Parallel()
+
+	h := bcrypt.NewHasher().WithCost(gobcrypt.MinCost)
+
+	hash, err := h.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !h.Recognizes(hash) || h.NeedsRehash(hash) {
+		t.Fatalf("fresh hash %q is not recognized or needs a rehash", hash)
+	}
+
+	err = h.Compare("password", hash)
+	if err != nil {
+		t.Fatalf("got %v for the right password", err)
+	}
+
+	err = h.Compare("wrong", hash)
+	if !errors.Is(err, hasher.ErrMismatched) {
+		t.Fatalf("got %v, want %v", err, hasher.ErrMismatched)
+	}
+
+	if !bcrypt.NewHasher().WithCost(gobcrypt.MinCost + 1).NeedsRehash(hash) {
+		t.Fatal("a hash of a lower cost does not need a rehash")
+	}
+}
+
+func TestMaxPasswordBytes(t *testing.T) {
+	t.Parallel()
+
+	h := bcrypt.NewHasher().WithCost(gobcrypt.MinCost)
+	longest := strings.Repeat("a", bcrypt.MaxPasswordBytes)
+
+	hash, err := h.Hash(longest)
+	if err != nil {
+		t.Fatalf("got %v for a password of %d bytes", err, bcrypt.MaxPasswordBytes)
+	}
+
+	err = h.Compare(longest, hash)
+	if err != nil {
+		t.Fatalf("got %v for the right password", err)
+	}
+
+	// bcrypt would silently ignore the 73rd byte, so it is refused instead of matching
+	_, err = h.Hash(longest + "a")
+	if err == nil {
+		t.Fatalf("hashed a password of %d bytes", bcrypt.MaxPasswordBytes+1)
+	}
+
+	err = h.Compare(longest+"a", hash)
+	if !errors.Is(err, hasher.ErrMismatched) {
+		t.Fatalf("got %v, want %v", err, hasher.ErrMismatched)
+	}
+}
@@ -3,5 +3,7 @@ package hasher
 import "errors"
 
 var (
-	ErrMismatched = errors.New("mismatched")
+	ErrMismatched  = errors.New("mismatched")
+	ErrUnknownHash = errors.New("unknown hash")
+	ErrInvalidHash = errors.New("invalid hash")
 )
@@ -3,4 +3,9 @@ package hasher
 type Hasher interface {
 	Hash(password string) (string, error)
 	Compare(password, hash string) error
+	// Recognizes reports whether hash was produced by this kind of hasher.
+	Recognizes(hash string) bool
+	// NeedsRehash reports whether hash should be replaced by a fresh Hash of the password,
+	// because it was produced by another algorithm or with weaker parameters.
+	NeedsRehash(hash string) bool
 }
@@ -0,0 +1,100 @@
+package legacy
+
+import (
+	"crypto/md5" //nolint:gosec
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+)
+
+const (
+	apr1Prefix = "$apr1$"
+	apr1Rounds = 1000
+	crypt64    = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+func compareAPR1(password, hash string) (bool, error) {
+	salt, _, ok := strings.Cut(strings.TrimPrefix(hash, apr1Prefix), "$")
+	if !ok || len(salt) > 8 {
+		return false, fmt.Errorf("apr1 hash: %w", hasher.ErrInvalidHash)
+	}
+
+	computed := apr1(password, salt)
+
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(hash)) == 1, nil
+}
+
+// apr1 is the MD5 based crypt of Apache, which only differs from FreeBSD's MD5 crypt by its magic.
+func apr1(password, salt string) string {
+	pw := []byte(password)
+
+	alternate := md5.New() //nolint:gosec
+	alternate.Write(pw)
+	alternate.Write([]byte(salt))
+	alternate.Write(pw)
+	sum := alternate.Sum(nil)
+
+	digest := md5.New() //nolint:gosec
+	digest.Write(pw)
+	digest.Write([]byte(apr1Prefix))
+	digest.Write([]byte(salt))
+
+	for i := len(pw); i > 0; i -= md5.Size {
+		digest.Write(sum[:min(i, md5.Size)])
+	}
+
+	for i := len(pw); i > 0; i >>= 1 {
+		if i&1 == 1 {
+			digest.Write([]byte{0})
+		} else {
+			digest.Write(pw[:1])
+		}
+	}
+
+	sum = digest.Sum(nil)
+
+	for i := range apr1Rounds {
+		round := md5.New() //nolint:gosec
+
+		if i%2 == 1 {
+			round.Write(pw)
+		} else {
+			round.Write(sum)
+		}
+
+		if i%3 != 0 {
+			round.Write([]byte(salt))
+		}
+
+		if i%7 != 0 {
+			round.Write(pw)
+		}
+
+		if i%2 == 1 {
+			round.Write(sum)
+		} else {
+			round.Write(pw)
+		}
+
+		sum = round.Sum(nil)
+	}
+
+	var encoded strings.Builder
+
+	for _, group := range [][3]int{{0, 6, 12}, {1, 7, 13}, {2, 8, 14}, {3, 9, 15}, {4, 10, 5}} {
+		encodeCrypt64(&encoded, uint(sum[group[0]])<<16|uint(sum[group[1]])<<8|uint(sum[group[2]]), 4) //nolint:mnd
+	}
+
+	encodeCrypt64(&encoded, uint(sum[11]), 2) //nolint:mnd
+
+	return apr1Prefix + salt + "$" + encoded.String()
+}
+
+func encodeCrypt64(builder *strings.Builder, value uint, n int) {
+	for range n {
+		builder.WriteByte(crypt64[value&0x3f])
+		value >>= 6
+	}
+}
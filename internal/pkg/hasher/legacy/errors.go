@@ -0,0 +1,5 @@
+package legacy
+
+import "errors"
+
+var ErrVerifyOnly = errors.New("legacy hashes can only be verified")
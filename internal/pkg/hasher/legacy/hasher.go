@@ -0,0 +1,71 @@
+package legacy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+)
+
+var _ hasher.Hasher = (*Hasher)(nil)
+
+// Hasher verifies hashes taken over from other systems, so imported users can log in with their old
+// passwords until the hash is replaced by one of the current algorithm. It recognizes
+//
+//	$apr1$<salt>$<hash>                         Apache htpasswd APR1
+//	{SHA}<hash>                                 Apache htpasswd and LDAP SHA1
+//	{SSHA}<hash and salt>                       LDAP salted SHA1
+//	pbkdf2_sha256$<iterations>$<salt>$<hash>    Django PBKDF2, also pbkdf2_sha1
+//
+// bcrypt hashes of htpasswd are left to the bcrypt hasher. New hashes are never produced.
+type Hasher struct{}
+
+func NewHasher() *Hasher {
+	return &Hasher{}
+}
+
+func (h *Hasher) Hash(string) (string, error) {
+	return "", ErrVerifyOnly
+}
+
+func (h *Hasher) Compare(password, hash string) error {
+	var (
+		ok  bool
+		err error
+	)
+
+	switch {
+	case strings.HasPrefix(hash, apr1Prefix):
+		ok, err = compareAPR1(password, hash)
+	case strings.HasPrefix(hash, shaPrefix):
+		ok, err = compareSHA(password, hash)
+	case strings.HasPrefix(hash, sshaPrefix):
+		ok, err = compareSSHA(password, hash)
+	case hasPBKDF2Prefix(hash):
+		ok, err = comparePBKDF2(password, hash)
+	default:
+		return fmt.Errorf("failed to compare hash and password: %w", hasher.ErrUnknownHash)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return hasher.ErrMismatched
+	}
+
+	return nil
+}
+
+func (h *Hasher) Recognizes(hash string) bool {
+	return strings.HasPrefix(hash, apr1Prefix) ||
+		strings.HasPrefix(hash, shaPrefix) ||
+		strings.HasPrefix(hash, sshaPrefix) ||
+		hasPBKDF2Prefix(hash)
+}
+
+// NeedsRehash always reports true, a legacy hash is replaced on the first successful login.
+func (h *Hasher) NeedsRehash(string) bool {
+	return true
+}
@@ -0,0 +1,96 @@
+package legacy_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/legacy"
+)
+
+// The vectors were made by other implementations: APR1 by openssl passwd -apr1, the others by Python's hashlib.
+func TestKnownAnswers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		password string
+		hash     string
+	}{
+		{name: "apr1", password: "password", hash: "$apr1$r31....$kMmt8Ia8qcWk4vKKEhpgx1"},
+		{name: "apr1 with 8 byte salt", password: "Correct-Horse-9!", hash: "$apr1$saltsalt$F0CiWLEzWSH4/YYY0mdud."},
+		{name: "sha", password: "password", hash: "{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g="},
+		{name: "ssha", password: "password", hash: "{SSHA}vpfBLgNNk+0h9jarAltvFs4cK/sBAgMEc2FsdA=="},
+		{name: "django pbkdf2 sha256", password: "password",
+			hash: "pbkdf2_sha256$1000$seasalt$YIWkt6M1JFXrHg5s0jZjBSc7C2Cz6QvchSJ0h8Y+i7c="},
+		{name: "django pbkdf2 sha1", password: "password", hash: "pbkdf2_sha1$1000$seasalt$C8KvRfPW529R7JpDHEDOP35Xr0g="},
+	}
+
+	h := legacy.NewHasher()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if !h.Recognizes(tt.hash) {
+				t.Fatalf("did not recognize %q", tt.hash)
+			}
+
+			err := h.Compare(tt.password, tt.hash)
+			if err != nil {
+				t.Fatalf("got %v for the right password", err)
+			}
+
+			err = h.Compare(tt.password+"x", tt.hash)
+			if !errors.Is(err, hasher.ErrMismatched) {
+				t.Fatalf("got %v, want %v", err, hasher.ErrMismatched)
+			}
+
+			if !h.NeedsRehash(tt.hash) {
+				t.Fatal("a legacy hash does not need a rehash")
+			}
+		})
+	}
+}
+
+func TestMalformedHashes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		hash string
+		want error
+	}{
+		{name: "apr1 without hash", hash: "$apr1$saltsalt", want: hasher.ErrInvalidHash},
+		{name: "apr1 salt too long", hash: "$apr1$saltsaltsalt$F0CiWLEzWSH4/YYY0mdud.", want: hasher.ErrInvalidHash},
+		{name: "sha not base64", hash: "{SHA}not base64", want: hasher.ErrInvalidHash},
+		{name: "sha too short", hash: "{SHA}AAAA", want: hasher.ErrInvalidHash},
+		{name: "ssha without salt", hash: "{SSHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=", want: hasher.ErrInvalidHash},
+		{name: "pbkdf2 zero iterations", hash: "pbkdf2_sha256$0$seasalt$YIWk", want: hasher.ErrInvalidHash},
+		{name: "pbkdf2 missing field", hash: "pbkdf2_sha256$1000$seasalt", want: hasher.ErrInvalidHash},
+		{name: "pbkdf2 empty key", hash: "pbkdf2_sha256$1000$seasalt$", want: hasher.ErrInvalidHash},
+		{name: "unknown", hash: "md5$salt$hash", want: hasher.ErrUnknownHash},
+	}
+
+	h := legacy.NewHasher()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := h.Compare("password", tt.hash)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashIsRefused(t *testing.T) {
+	t.Parallel()
+
+	_, err := legacy.NewHasher().Hash("password")
+	if !errors.Is(err, legacy.ErrVerifyOnly) {
+		t.Fatalf("got %v, want %v", err, legacy.ErrVerifyOnly)
+	}
+}
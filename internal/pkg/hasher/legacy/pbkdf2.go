@@ -0,0 +1,51 @@
+package legacy
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+)
+
+// pbkdf2Algorithms are the Django PBKDF2 hashers by their algorithm name.
+var pbkdf2Algorithms = map[string]func() hash.Hash{ //nolint:gochecknoglobals
+	"pbkdf2_sha256": sha256.New,
+	"pbkdf2_sha1":   sha1.New,
+}
+
+func hasPBKDF2Prefix(hash string) bool {
+	algorithm, _, ok := strings.Cut(hash, "$")
+
+	return ok && pbkdf2Algorithms[algorithm] != nil
+}
+
+func comparePBKDF2(password, encoded string) (bool, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 4 { //nolint:mnd
+		return false, fmt.Errorf("pbkdf2 hash: %w", hasher.ErrInvalidHash)
+	}
+
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations < 1 {
+		return false, fmt.Errorf("pbkdf2 iterations: %w", hasher.ErrInvalidHash)
+	}
+
+	expected, err := base64.StdEncoding.DecodeString(parts[3])
+	if err != nil || len(expected) == 0 {
+		return false, fmt.Errorf("pbkdf2 key: %w", hasher.ErrInvalidHash)
+	}
+
+	key, err := pbkdf2.Key(pbkdf2Algorithms[parts[0]], password, []byte(parts[2]), iterations, len(expected))
+	if err != nil {
+		return false, fmt.Errorf("failed to derive pbkdf2 key: %w", err)
+	}
+
+	return subtle.ConstantTimeCompare(key, expected) == 1, nil
+}
@@ -0,0 +1,40 @@
+package legacy
+
+import (
+	"crypto/sha1" //nolint:gosec
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+)
+
+const (
+	shaPrefix  = "{SHA}"
+	sshaPrefix = "{SSHA}"
+)
+
+func compareSHA(password, hash string) (bool, error) {
+	expected, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(hash, shaPrefix))
+	if err != nil || len(expected) != sha1.Size {
+		return false, fmt.Errorf("sha hash: %w", hasher.ErrInvalidHash)
+	}
+
+	sum := sha1.Sum([]byte(password)) //nolint:gosec
+
+	return subtle.ConstantTimeCompare(sum[:], expected) == 1, nil
+}
+
+// compareSSHA verifies the salted SHA1 of LDAP, the base64 of the digest of password and salt followed by the salt.
+func compareSSHA(password, hash string) (bool, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(hash, sshaPrefix))
+	if err != nil || len(decoded) <= sha1.Size {
+		return false, fmt.Errorf("ssha hash: %w", hasher.ErrInvalidHash)
+	}
+
+	expected, salt := decoded[:sha1.Size], decoded[sha1.Size:]
+	sum := sha1.Sum(append([]byte(password), salt...)) //nolint:gosec
+
+	return subtle.ConstantTimeCompare(sum[:], expected) == 1, nil
+}
@@ -0,0 +1,66 @@
+package multi
+
+import (
+	"fmt"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+)
+
+var _ hasher.Hasher = (*Hasher)(nil)
+
+// Hasher hashes new passwords with its primary hasher and compares a stored hash with whichever
+// hasher recognizes it, so hashes of older algorithms keep working until they are rehashed.
+type Hasher struct {
+	primary hasher.Hasher
+	others  []hasher.Hasher
+}
+
+func NewHasher(primary hasher.Hasher, others ...hasher.Hasher) *Hasher {
+	return &Hasher{
+		primary: primary,
+		others:  others,
+	}
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	return h.primary.Hash(password) //nolint:wrapcheck
+}
+
+// Compare returns hasher.ErrUnknownHash if no hasher recognizes the hash.
+func (h *Hasher) Compare(password, hash string) error {
+	recognizer, ok := h.recognizer(hash)
+	if !ok {
+		return fmt.Errorf("failed to compare hash and password: %w", hasher.ErrUnknownHash)
+	}
+
+	return recognizer.Compare(password, hash) //nolint:wrapcheck
+}
+
+func (h *Hasher) Recognizes(hash string) bool {
+	_, ok := h.recognizer(hash)
+
+	return ok
+}
+
+// NeedsRehash reports whether the hash was not produced by the primary hasher with its current parameters.
+func (h *Hasher) NeedsRehash(hash string) bool {
+	if !h.primary.Recognizes(hash) {
+		return true
+	}
+
+	return h.primary.NeedsRehash(hash)
+}
+
+func (h *Hasher) recognizer(hash string) (hasher.Hasher, bool) {
+	if h.primary.Recognizes(hash) {
+		return h.primary, true
+	}
+
+	for _, other := range h.others {
+		if other.Recognizes(hash) {
+			return other, true
+		}
+	}
+
+	return nil, false
+}
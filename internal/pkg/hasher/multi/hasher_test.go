@@ -0,0 +1,107 @@
+package multi_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/argon2id"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/bcrypt"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/legacy"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/multi"
+	gobcrypt "golang.org/x/crypto/bcrypt"
+)
+
+func newHasher() (*multi.Hasher, *argon2id.Hasher, *bcrypt.Hasher) {
+	primary := argon2id.NewHasher(argon2id.Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16,
+		KeyLength: 32})
+	other := bcrypt.NewHasher().WithCost(gobcrypt.MinCost)
+
+	return multi.NewHasher(primary, other, legacy.NewHasher()), primary, other
+}
+
+func TestCompareChoosesHasherByPrefix(t *testing.T) {
+	t.Parallel()
+
+	h, primary, other := newHasher()
+
+	argon2Hash, err := primary.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bcryptHash, err := other.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		hash       string
+		wantRehash bool
+	}{
+		{name: "primary", hash: argon2Hash, wantRehash: false},
+		{name: "other", hash: bcryptHash, wantRehash: true},
+		{name: "legacy", hash: "{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=", wantRehash: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if !h.Recognizes(tt.hash) {
+				t.Fatalf("did not recognize %q", tt.hash)
+			}
+
+			err := h.Compare("password", tt.hash)
+			if err != nil {
+				t.Fatalf("got %v for the right password", err)
+			}
+
+			err = h.Compare("wrong", tt.hash)
+			if !errors.Is(err, hasher.ErrMismatched) {
+				t.Fatalf("got %v, want %v", err, hasher.ErrMismatched)
+			}
+
+			if h.NeedsRehash(tt.hash) != tt.wantRehash {
+				t.Fatalf("got needs rehash %t, want %t", h.NeedsRehash(tt.hash), tt.wantRehash)
+			}
+		})
+	}
+}
+
+func TestUnknownHash(t *testing.T) {
+	t.Parallel()
+
+	h, _, _ := newHasher()
+
+	for _, hash := range []string{"", "plain", "$1$md5crypt$", "$argon2id$v=19$m=64,t=0,p=1$c2FsdA$a2V5"} {
+		if h.Recognizes(hash) {
+			t.Errorf("recognized %q", hash)
+		}
+
+		err := h.Compare("password", hash)
+		if !errors.Is(err, hasher.ErrUnknownHash) {
+			t.Errorf("%q: got %v, want %v", hash, err, hasher.ErrUnknownHash)
+		}
+
+		if !h.NeedsRehash(hash) {
+			t.Errorf("%q does not need a rehash", hash)
+		}
+	}
+}
+
+func TestHashUsesPrimary(t *testing.T) {
+	t.Parallel()
+
+	h, primary, _ := newHasher()
+
+	hash, err := h.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !primary.Recognizes(hash) {
+		t.Fatalf("%q is not a hash of the primary hasher", hash)
+	}
+}
@@ -0,0 +1,5 @@
+package pepper
+
+import "errors"
+
+var ErrInvalidPepper = errors.New("pepper needs an ID without '$' or ':' and a key")
@@ -0,0 +1,125 @@
+package pepper
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+)
+
+var _ hasher.Hasher = (*Hasher)(nil)
+
+const prefix = "$pepper$"
+
+// Pepper is a secret key mixed into every password before hashing. Unlike the hashes it is never stored
+// with the credentials, so leaked hashes cannot be cracked without it. The ID names the pepper in the hash,
+// so peppers can be rotated.
+type Pepper struct {
+	ID  string
+	Key []byte
+}
+
+// Hasher peppers passwords with HMAC-SHA256 before handing them to the inner hasher, and prefixes the
+// inner hash with the ID of the pepper, e.g.
+//
+//	$pepper$2$argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>
+//
+// Hashes without a pepper or with an older pepper are still accepted and need a rehash.
+// The HMAC also keeps long passwords below the 72 bytes bcrypt looks at.
+type Hasher struct {
+	inner   hasher.Hasher
+	current Pepper
+	peppers map[string]Pepper
+}
+
+// NewHasher peppers new hashes with current. Hashes peppered with one of the older peppers are still accepted.
+func NewHasher(inner hasher.Hasher, current Pepper, older ...Pepper) (*Hasher, error) {
+	peppers := make(map[string]Pepper, len(older)+1)
+
+	for _, pepper := range append([]Pepper{current}, older...) {
+		if pepper.ID == "" || strings.ContainsAny(pepper.ID, "$:") || len(pepper.Key) == 0 {
+			return nil, fmt.Errorf("pepper %q: %w", pepper.ID, ErrInvalidPepper)
+		}
+
+		if _, ok := peppers[pepper.ID]; ok {
+			return nil, fmt.Errorf("pepper %q is given twice: %w", pepper.ID, ErrInvalidPepper)
+		}
+
+		peppers[pepper.ID] = pepper
+	}
+
+	return &Hasher{
+		inner:   inner,
+		current: current,
+		peppers: peppers,
+	}, nil
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	hash, err := h.inner.Hash(season(h.current, password))
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	return prefix + h.current.ID + hash, nil
+}
+
+// Compare returns hasher.ErrUnknownHash if the hash was peppered with an unknown pepper.
+func (h *Hasher) Compare(password, hash string) error {
+	pepper, inner, peppered, err := h.split(hash)
+	if err != nil {
+		return err
+	}
+
+	if !peppered {
+		return h.inner.Compare(password, hash) //nolint:wrapcheck
+	}
+
+	return h.inner.Compare(season(pepper, password), inner) //nolint:wrapcheck
+}
+
+func (h *Hasher) Recognizes(hash string) bool {
+	_, inner, _, err := h.split(hash)
+
+	return err == nil && h.inner.Recognizes(inner)
+}
+
+// NeedsRehash reports whether the hash lacks the current pepper or its inner hash needs a rehash.
+func (h *Hasher) NeedsRehash(hash string) bool {
+	pepper, inner, peppered, err := h.split(hash)
+	if err != nil || !peppered || pepper.ID != h.current.ID {
+		return true
+	}
+
+	return h.inner.NeedsRehash(inner)
+}
+
+// split returns the pepper and the inner hash of a peppered hash, or the hash itself if it has no pepper.
+func (h *Hasher) split(hash string) (Pepper, string, bool, error) {
+	rest, ok := strings.CutPrefix(hash, prefix)
+	if !ok {
+		return Pepper{}, hash, false, nil
+	}
+
+	end := strings.IndexByte(rest, '$')
+	if end < 0 {
+		return Pepper{}, "", false, fmt.Errorf("pepper without hash: %w", hasher.ErrInvalidHash)
+	}
+
+	pepper, ok := h.peppers[rest[:end]]
+	if !ok {
+		return Pepper{}, "", false, fmt.Errorf("pepper %q: %w", rest[:end], hasher.ErrUnknownHash)
+	}
+
+	return pepper, rest[end:], true, nil
+}
+
+func season(pepper Pepper, password string) string {
+	mac := hmac.New(sha256.New, pepper.Key)
+	mac.Write([]byte(password))
+
+	return base64.RawStdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,158 @@
+package pepper_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/argon2id"
+	"github.com/neatflowcv/key-stone/internal/pkg/hasher/pepper"
+)
+
+func inner() *argon2id.Hasher {
+	return argon2id.NewHasher(argon2id.Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16,
+		KeyLength: 32})
+}
+
+var (
+	pepper1 = pepper.Pepper{ID: "1", Key: []byte("first secret")}  //nolint:gochecknoglobals
+	pepper2 = pepper.Pepper{ID: "2", Key: []byte("second secret")} //nolint:gochecknoglobals
+)
+
+func newHasher(t *testing.T, current pepper.Pepper, older ...pepper.Pepper) *pepper.Hasher {
+	t.Helper()
+
+	h, err := pepper.NewHasher(inner(), current, older...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return h
+}
+
+func TestHashAndCompare(t *testing.T) {
+	t.Parallel()
+
+	h := newHasher(t, pepper2, pepper1)
+
+	hash, err := h.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the current pepper is named in the hash
+	if !strings.HasPrefix(hash, "$pepper$2$argon2id$") {
+		t.Fatalf("got %q, want the current pepper 2", hash)
+	}
+
+	if !h.Recognizes(hash) || h.NeedsRehash(hash) {
+		t.Fatalf("fresh hash %q is not recognized or needs a rehash", hash)
+	}
+
+	err = h.Compare("password", hash)
+	if err != nil {
+		t.Fatalf("got %v for the right password", err)
+	}
+
+	err = h.Compare("wrong", hash)
+	if !errors.Is(err, hasher.ErrMismatched) {
+		t.Fatalf("got %v, want %v", err, hasher.ErrMismatched)
+	}
+}
+
+func TestPepperVersions(t *testing.T) {
+	t.Parallel()
+
+	old, err := newHasher(t, pepper1).Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unpeppered, err := inner().Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a hash of pepper 2 that is compared with the key of pepper 1 would not match
+	forged := strings.Replace(old, "$pepper$1$", "$pepper$2$", 1)
+
+	tests := []struct {
+		name       string
+		hash       string
+		wantErr    error
+		wantRehash bool
+	}{
+		{name: "older pepper", hash: old, wantErr: nil, wantRehash: true},
+		{name: "no pepper", hash: unpeppered, wantErr: nil, wantRehash: true},
+		{name: "hash under the wrong pepper", hash: forged, wantErr: hasher.ErrMismatched, wantRehash: false},
+		{name: "unknown pepper", hash: strings.Replace(old, "$pepper$1$", "$pepper$9$", 1),
+			wantErr: hasher.ErrUnknownHash, wantRehash: true},
+		{name: "pepper without hash", hash: "$pepper$1", wantErr: hasher.ErrInvalidHash, wantRehash: true},
+	}
+
+	h := newHasher(t, pepper2, pepper1)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := h.Compare("password", tt.hash)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got %v, want %v", err, tt.wantErr)
+			}
+
+			if h.NeedsRehash(tt.hash) != tt.wantRehash {
+				t.Fatalf("got needs rehash %t, want %t", h.NeedsRehash(tt.hash), tt.wantRehash)
+			}
+		})
+	}
+}
+
+func TestRetiredPepper(t *testing.T) {
+	t.Parallel()
+
+	old, err := newHasher(t, pepper1).Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// once pepper 1 is no longer configured its hashes cannot be verified
+	h := newHasher(t, pepper2)
+
+	err = h.Compare("password", old)
+	if !errors.Is(err, hasher.ErrUnknownHash) {
+		t.Fatalf("got %v, want %v", err, hasher.ErrUnknownHash)
+	}
+
+	if h.Recognizes(old) {
+		t.Fatal("recognized a hash of a retired pepper")
+	}
+}
+
+func TestNewHasherRejectsInvalidPeppers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		current pepper.Pepper
+		older   []pepper.Pepper
+	}{
+		{name: "empty id", current: pepper.Pepper{ID: "", Key: []byte("secret")}, older: nil},
+		{name: "id with dollar", current: pepper.Pepper{ID: "a$b", Key: []byte("secret")}, older: nil},
+		{name: "id with colon", current: pepper.Pepper{ID: "a:b", Key: []byte("secret")}, older: nil},
+		{name: "empty key", current: pepper.Pepper{ID: "1", Key: nil}, older: nil},
+		{name: "duplicate id", current: pepper1, older: []pepper.Pepper{{ID: "1", Key: []byte("other")}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := pepper.NewHasher(inner(), tt.current, tt.older...)
+			if !errors.Is(err, pepper.ErrInvalidPepper) {
+				t.Fatalf("got %v, want %v", err, pepper.ErrInvalidPepper)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package mailer
+
+import "errors"
+
+var (
+	ErrInvalidAddress = errors.New("invalid mail address")
+	ErrInvalidHeader  = errors.New("mail header must not span lines")
+)
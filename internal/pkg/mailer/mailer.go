@@ -0,0 +1,12 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+// Mailer delivers mails to users, e.g. password reset links.
+type Mailer interface {
+	Send(ctx context.Context, mail *domain.Mail) error
+}
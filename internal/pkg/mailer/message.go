@@ -0,0 +1,66 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+// Compose renders the mail as an RFC 5322 message with a quoted-printable UTF-8 body, as sent over SMTP.
+// Returns ErrInvalidAddress if the sender or the recipient is no address, ErrInvalidHeader if the subject
+// spans lines.
+func Compose(from string, message *domain.Mail, date time.Time) ([]byte, error) {
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("sender %q: %w", from, ErrInvalidAddress)
+	}
+
+	recipient, err := mail.ParseAddress(message.To())
+	if err != nil {
+		return nil, fmt.Errorf("recipient %q: %w", message.To(), ErrInvalidAddress)
+	}
+
+	if strings.ContainsAny(message.Subject(), "\r\n") {
+		return nil, ErrInvalidHeader
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "From: %s\r\n", sender.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", recipient.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject()))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	body := quotedprintable.NewWriter(&buf)
+
+	_, err = body.Write([]byte(strings.ReplaceAll(message.Body(), "\n", "\r\n")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %w", err)
+	}
+
+	err = body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode body: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Address returns the bare address of a sender or recipient such as "Key Stone <no-reply@example.com>".
+func Address(address string) (string, error) {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", fmt.Errorf("address %q: %w", address, ErrInvalidAddress)
+	}
+
+	return parsed.Address, nil
+}
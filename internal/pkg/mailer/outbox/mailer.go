@@ -0,0 +1,102 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/atomicfile"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/mailer"
+)
+
+var _ mailer.Mailer = (*Mailer)(nil)
+
+// Mailer keeps mails instead of delivering them, for development and tests. Each mail is either written
+// to a directory as an .eml file or printed to a writer such as stdout.
+type Mailer struct {
+	mu     sync.Mutex
+	from   string
+	dir    string
+	writer io.Writer
+	sent   int
+}
+
+// NewMailer writes mails into the directory path, or to stdout if path is "-".
+func NewMailer(from, path string) (*Mailer, error) {
+	const createPerm = 0750
+
+	_, err := mailer.Address(from)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if path == "-" {
+		return NewWriterMailer(from, os.Stdout), nil
+	}
+
+	err = os.MkdirAll(path, createPerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outbox: %w", err)
+	}
+
+	return &Mailer{
+		mu:     sync.Mutex{},
+		from:   from,
+		dir:    path,
+		writer: nil,
+		sent:   0,
+	}, nil
+}
+
+// NewWriterMailer writes mails to writer, one after another.
+func NewWriterMailer(from string, writer io.Writer) *Mailer {
+	return &Mailer{
+		mu:     sync.Mutex{},
+		from:   from,
+		dir:    "",
+		writer: writer,
+		sent:   0,
+	}
+}
+
+func (m *Mailer) Send(ctx context.Context, mail *domain.Mail) error {
+	err := ctx.Err()
+	if err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+
+	now := time.Now()
+
+	message, err := mailer.Compose(m.from, mail, now)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.writer != nil {
+		_, err := fmt.Fprintf(m.writer, "%s\r\n", message)
+		if err != nil {
+			return fmt.Errorf("failed to write mail: %w", err)
+		}
+
+		return nil
+	}
+
+	// the counter keeps names unique even if the clock is coarse
+	m.sent++
+	name := fmt.Sprintf("%s-%d.eml", now.UTC().Format("20060102T150405.000000000Z"), m.sent)
+
+	err = atomicfile.Create(filepath.Join(m.dir, name), message)
+	if err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package smtp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/mailer"
+)
+
+var _ mailer.Mailer = (*Mailer)(nil)
+
+// Mailer sends mails through an SMTP server, upgrading the connection with STARTTLS if the server offers it.
+// Credentials are only sent over TLS or to localhost.
+type Mailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewMailer sends from the address from through the server at addr, given as host:port.
+// Without a username the server is used without authentication.
+func NewMailer(addr, from, username, password string) (*Mailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse smtp address: %w", err)
+	}
+
+	_, err = mailer.Address(from)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &Mailer{
+		addr: addr,
+		from: from,
+		auth: auth,
+	}, nil
+}
+
+func (m *Mailer) Send(ctx context.Context, mail *domain.Mail) error {
+	err := ctx.Err()
+	if err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+
+	message, err := mailer.Compose(m.from, mail, time.Now())
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	from, err := mailer.Address(m.from)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	to, err := mailer.Address(mail.To())
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	err = smtp.SendMail(m.addr, m.auth, from, []string{to}, message)
+	if err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+
+	return nil
+}
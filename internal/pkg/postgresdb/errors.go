@@ -0,0 +1,5 @@
+package postgresdb
+
+import "errors"
+
+var ErrUnknownSchemaVersion = errors.New("database was created by a newer version")
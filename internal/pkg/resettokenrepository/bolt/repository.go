@@ -0,0 +1,143 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ resettokenrepository.Repository = (*Repository)(nil)
+
+var bucketResetTokens = []byte("reset-tokens") //nolint:gochecknoglobals
+
+// Repository stores reset tokens in the "reset-tokens" bucket of a shared bbolt file, keyed by token ID.
+// Deleting the key is what uses the token up, bbolt runs one writing transaction at a time,
+// so only one delete finds it.
+type Repository struct {
+	db *bolt.DB
+}
+
+type record struct {
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewRepository uses db, usually opened by boltdb.Open. The caller keeps ownership of db.
+func NewRepository(db *bolt.DB) (*Repository, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketResetTokens)
+
+		return err //nolint:wrapcheck
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reset-tokens bucket: %w", err)
+	}
+
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) CreateResetToken(ctx context.Context, token *domain.ResetToken) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	data, err := json.Marshal(&record{
+		Username:  token.Username(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode reset token: %w", err)
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		bucket := tx.Bucket(bucketResetTokens)
+		key := []byte(token.ID())
+
+		if bucket.Get(key) != nil {
+			return resettokenrepository.ErrResetTokenAlreadyExists
+		}
+
+		err := bucket.Put(key, data)
+		if err != nil {
+			return fmt.Errorf("failed to create reset token: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) GetResetToken(ctx context.Context, id string, now time.Time) (*domain.ResetToken, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var token *domain.ResetToken
+
+	err = r.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketResetTokens).Get([]byte(id))
+		if data == nil {
+			return resettokenrepository.ErrResetTokenNotFound
+		}
+
+		// data is only valid during the transaction, decoding copies it
+		var rec record
+
+		err := json.Unmarshal(data, &rec)
+		if err != nil {
+			return fmt.Errorf("failed to decode reset token: %w", err)
+		}
+
+		token = domain.NewResetToken(id, rec.Username, rec.CreatedAt, rec.ExpiresAt)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if token.IsExpired(now) {
+		// nothing else removes expired tokens, so the read does, like the one of the file repository
+		err := r.DeleteResetToken(ctx, id)
+		if err != nil && !errors.Is(err, resettokenrepository.ErrResetTokenNotFound) {
+			log.Printf("failed to remove expired reset token: %v", err)
+		}
+
+		return nil, resettokenrepository.ErrResetTokenNotFound
+	}
+
+	return token, nil
+}
+
+func (r *Repository) DeleteResetToken(ctx context.Context, id string) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		bucket := tx.Bucket(bucketResetTokens)
+		key := []byte(id)
+
+		if bucket.Get(key) == nil {
+			return resettokenrepository.ErrResetTokenNotFound
+		}
+
+		err := bucket.Delete(key)
+		if err != nil {
+			return fmt.Errorf("failed to delete reset token: %w", err)
+		}
+
+		return nil
+	})
+}
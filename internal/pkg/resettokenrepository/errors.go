@@ -0,0 +1,8 @@
+package resettokenrepository
+
+import "errors"
+
+var (
+	ErrResetTokenNotFound      = errors.New("reset token not found")
+	ErrResetTokenAlreadyExists = errors.New("reset token already exists")
+)
@@ -0,0 +1,111 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/atomicfile"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+)
+
+var _ resettokenrepository.Repository = (*Repository)(nil)
+
+// Repository stores one file per reset token, named by the token ID.
+// Removing the file is what uses the token up, the file system lets only one remove succeed.
+type Repository struct {
+	path string
+}
+
+type record struct {
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewRepository(path string) (*Repository, error) {
+	const createPerm = 0750
+
+	err := os.MkdirAll(path, createPerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	return &Repository{path: path}, nil
+}
+
+func (r *Repository) CreateResetToken(ctx context.Context, token *domain.ResetToken) error {
+	data, err := json.Marshal(&record{
+		Username:  token.Username(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode reset token: %w", err)
+	}
+
+	err = atomicfile.Create(r.filePath(token.ID()), data)
+	if err != nil {
+		if os.IsExist(err) {
+			return resettokenrepository.ErrResetTokenAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create reset token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetResetToken(ctx context.Context, id string, now time.Time) (*domain.ResetToken, error) {
+	filePath := r.filePath(id)
+
+	data, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, resettokenrepository.ErrResetTokenNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read reset token: %w", err)
+	}
+
+	var rec record
+
+	err = json.Unmarshal(data, &rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode reset token: %w", err)
+	}
+
+	token := domain.NewResetToken(id, rec.Username, rec.CreatedAt, rec.ExpiresAt)
+	if token.IsExpired(now) {
+		err := os.Remove(filePath)
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("failed to remove expired reset token: %v", err)
+		}
+
+		return nil, resettokenrepository.ErrResetTokenNotFound
+	}
+
+	return token, nil
+}
+
+func (r *Repository) DeleteResetToken(ctx context.Context, id string) error {
+	err := os.Remove(r.filePath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return resettokenrepository.ErrResetTokenNotFound
+		}
+
+		return fmt.Errorf("failed to delete reset token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) filePath(id string) string {
+	return filepath.Join(r.path, filepath.Base(id))
+}
@@ -0,0 +1,24 @@
+package file_test
+
+import (
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/file"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(t *testing.T) resettokenrepository.Repository {
+		t.Helper()
+
+		repo, err := file.NewRepository(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return repo
+	})
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+)
+
+var _ resettokenrepository.Repository = (*Repository)(nil)
+
+type Repository struct {
+	mu     sync.Mutex
+	tokens map[string]*domain.ResetToken
+}
+
+func NewRepository() *Repository {
+	return &Repository{
+		mu:     sync.Mutex{},
+		tokens: make(map[string]*domain.ResetToken),
+	}
+}
+
+func (r *Repository) CreateResetToken(ctx context.Context, token *domain.ResetToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[token.ID()]; ok {
+		return resettokenrepository.ErrResetTokenAlreadyExists
+	}
+
+	r.tokens[token.ID()] = token
+
+	return nil
+}
+
+func (r *Repository) GetResetToken(ctx context.Context, id string, now time.Time) (*domain.ResetToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.tokens[id]
+	if !ok {
+		return nil, resettokenrepository.ErrResetTokenNotFound
+	}
+
+	if token.IsExpired(now) {
+		delete(r.tokens, id)
+
+		return nil, resettokenrepository.ErrResetTokenNotFound
+	}
+
+	return token, nil
+}
+
+func (r *Repository) DeleteResetToken(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[id]; !ok {
+		return resettokenrepository.ErrResetTokenNotFound
+	}
+
+	delete(r.tokens, id)
+
+	return nil
+}
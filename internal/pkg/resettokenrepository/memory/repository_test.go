@@ -0,0 +1,17 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/memory"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(_ *testing.T) resettokenrepository.Repository {
+		return memory.NewRepository()
+	})
+}
@@ -0,0 +1,15 @@
+package postgres
+
+// migrationsTable records the applied migrations of the reset tokens table.
+const migrationsTable = "reset_token_migrations"
+
+// migrations are applied in order by postgresdb.Migrate.
+// Never edit a released migration, append a new one instead.
+var migrations = []string{ //nolint:gochecknoglobals
+	`CREATE TABLE reset_tokens (
+		id         TEXT PRIMARY KEY,
+		username   TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL
+	)`,
+}
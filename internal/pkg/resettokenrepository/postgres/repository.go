@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+)
+
+var _ resettokenrepository.Repository = (*Repository)(nil)
+
+// Repository stores reset tokens in PostgreSQL.
+// Deleting the row is what uses the token up, the database lets only one delete succeed.
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRepository stores reset tokens in the database of pool and migrates their table.
+// The pool stays open, it belongs to the caller, see postgresdb.Open.
+func NewRepository(ctx context.Context, pool *pgxpool.Pool) (*Repository, error) {
+	err := postgresdb.Migrate(ctx, pool, migrationsTable, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate reset tokens: %w", err)
+	}
+
+	return &Repository{pool: pool}, nil
+}
+
+func (r *Repository) CreateResetToken(ctx context.Context, token *domain.ResetToken) error {
+	_, err := r.pool.Exec(ctx,
+		"INSERT INTO reset_tokens (id, username, created_at, expires_at) VALUES ($1, $2, $3, $4)",
+		token.ID(), token.Username(), token.CreatedAt(), token.ExpiresAt())
+	if err != nil {
+		if postgresdb.IsUniqueViolation(err) {
+			return resettokenrepository.ErrResetTokenAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create reset token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetResetToken(ctx context.Context, id string, now time.Time) (*domain.ResetToken, error) {
+	var (
+		username  string
+		createdAt time.Time
+		expiresAt time.Time
+	)
+
+	err := r.pool.QueryRow(ctx,
+		"SELECT username, created_at, expires_at FROM reset_tokens WHERE id = $1 AND expires_at >= $2",
+		id, now).Scan(&username, &createdAt, &expiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, resettokenrepository.ErrResetTokenNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read reset token: %w", err)
+	}
+
+	return domain.NewResetToken(id, username, createdAt, expiresAt), nil
+}
+
+func (r *Repository) DeleteResetToken(ctx context.Context, id string) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM reset_tokens WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete reset token: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return resettokenrepository.ErrResetTokenNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package postgres_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/postgres"
+	"github.com/neatflowcv/key-stone/internal/pkg/resettokenrepository/repositorytest"
+)
+
+// TestConformance needs a database in KS_REPOSITORY_DSN, e.g. the one make postgres starts.
+// The checks use random token IDs, so they can run against the same database again.
+func TestConformance(t *testing.T) {
+	dsn := os.Getenv("KS_REPOSITORY_DSN")
+	if dsn == "" {
+		t.Skip("KS_REPOSITORY_DSN is not set")
+	}
+
+	t.Parallel()
+
+	repositorytest.Run(t, func(t *testing.T) resettokenrepository.Repository {
+		t.Helper()
+
+		pool, err := postgresdb.Open(t.Context(), dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Cleanup(pool.Close)
+
+		repo, err := postgres.NewRepository(t.Context(), pool)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return repo
+	})
+}
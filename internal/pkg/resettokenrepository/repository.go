@@ -0,0 +1,17 @@
+package resettokenrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+type Repository interface {
+	CreateResetToken(ctx context.Context, token *domain.ResetToken) error
+	// GetResetToken returns ErrResetTokenNotFound for unknown tokens and for tokens expired at now.
+	GetResetToken(ctx context.Context, id string, now time.Time) (*domain.ResetToken, error)
+	// DeleteResetToken returns ErrResetTokenNotFound if the token is already gone. Of concurrent deletes of
+	// the same token only one succeeds, which makes a token single-use.
+	DeleteResetToken(ctx context.Context, id string) error
+}
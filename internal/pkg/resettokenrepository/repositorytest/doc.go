@@ -0,0 +1,3 @@
+// Package repositorytest holds the contract every resettokenrepository.Repository implementation must fulfil.
+// New backends are verified with Run from a test or with Verify.
+package repositorytest
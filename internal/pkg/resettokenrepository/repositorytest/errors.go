@@ -0,0 +1,5 @@
+package repositorytest
+
+import "errors"
+
+var ErrContractViolated = errors.New("repository violates the contract")
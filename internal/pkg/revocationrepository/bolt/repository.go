@@ -0,0 +1,156 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ revocationrepository.Repository = (*Repository)(nil)
+
+var bucketRevocations = []byte("revocations") //nolint:gochecknoglobals
+
+// Repository stores revocations in the "revocations" bucket of a shared bbolt file, keyed by token ID.
+// An expired revocation is left for DeleteExpiredRevocations, reads do not write.
+type Repository struct {
+	db *bolt.DB
+}
+
+type record struct {
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewRepository uses db, usually opened by boltdb.Open. The caller keeps ownership of db.
+func NewRepository(db *bolt.DB) (*Repository, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketRevocations)
+
+		return err //nolint:wrapcheck
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create revocations bucket: %w", err)
+	}
+
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) CreateRevocation(ctx context.Context, revocation *domain.Revocation) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	data, err := json.Marshal(&record{ExpiresAt: revocation.ExpiresAt()})
+	if err != nil {
+		return fmt.Errorf("failed to encode revocation: %w", err)
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		bucket := tx.Bucket(bucketRevocations)
+		key := []byte(revocation.TokenID())
+
+		if bucket.Get(key) != nil {
+			return revocationrepository.ErrRevocationAlreadyExists
+		}
+
+		err := bucket.Put(key, data)
+		if err != nil {
+			return fmt.Errorf("failed to create revocation: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) GetRevocation(ctx context.Context, tokenID string, now time.Time) (*domain.Revocation, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var revocation *domain.Revocation
+
+	err = r.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketRevocations).Get([]byte(tokenID))
+		if data == nil {
+			return revocationrepository.ErrRevocationNotFound
+		}
+
+		revocation, err = decode(tokenID, data)
+
+		return err
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if revocation.IsExpired(now) {
+		return nil, revocationrepository.ErrRevocationNotFound
+	}
+
+	return revocation, nil
+}
+
+func (r *Repository) DeleteExpiredRevocations(ctx context.Context, now time.Time) (int, error) {
+	err := ctx.Err()
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	var deleted int
+
+	err = r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketRevocations)
+
+		var expired [][]byte
+
+		err := bucket.ForEach(func(key, data []byte) error {
+			revocation, err := decode(string(key), data)
+			if err != nil {
+				return err
+			}
+
+			if revocation.IsExpired(now) {
+				expired = append(expired, key)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// a bucket must not be changed while it is iterated
+		for _, key := range expired {
+			err := bucket.Delete(key)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+		}
+
+		deleted = len(expired)
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired revocations: %w", err)
+	}
+
+	return deleted, nil
+}
+
+func decode(tokenID string, data []byte) (*domain.Revocation, error) {
+	var rec record
+
+	err := json.Unmarshal(data, &rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode revocation: %w", err)
+	}
+
+	return domain.NewRevocation(tokenID, rec.ExpiresAt), nil
+}
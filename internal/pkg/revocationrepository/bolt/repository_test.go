@@ -0,0 +1,35 @@
+package bolt_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/boltdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/bolt"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(t *testing.T) revocationrepository.Repository {
+		t.Helper()
+
+		db, err := boltdb.Open(filepath.Join(t.TempDir(), "key-stone.db"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Cleanup(func() {
+			_ = db.Close()
+		})
+
+		repo, err := bolt.NewRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return repo
+	})
+}
@@ -0,0 +1,8 @@
+package revocationrepository
+
+import "errors"
+
+var (
+	ErrRevocationNotFound      = errors.New("revocation not found")
+	ErrRevocationAlreadyExists = errors.New("revocation already exists")
+)
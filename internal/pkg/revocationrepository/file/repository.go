@@ -0,0 +1,127 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/atomicfile"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+)
+
+var _ revocationrepository.Repository = (*Repository)(nil)
+
+// Repository stores one file per revoked token, named by the token ID and holding its expiry.
+type Repository struct {
+	path string
+}
+
+func NewRepository(path string) (*Repository, error) {
+	const createPerm = 0750
+
+	err := os.MkdirAll(path, createPerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	return &Repository{path: path}, nil
+}
+
+func (r *Repository) CreateRevocation(ctx context.Context, revocation *domain.Revocation) error {
+	data := []byte(revocation.ExpiresAt().UTC().Format(time.RFC3339))
+
+	err := atomicfile.Create(r.filePath(revocation.TokenID()), data)
+	if err != nil {
+		if os.IsExist(err) {
+			return revocationrepository.ErrRevocationAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create revocation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetRevocation(ctx context.Context, tokenID string, now time.Time) (*domain.Revocation, error) {
+	revocation, err := r.read(tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if revocation.IsExpired(now) {
+		err := os.Remove(r.filePath(tokenID))
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("failed to remove expired revocation: %v", err)
+		}
+
+		return nil, revocationrepository.ErrRevocationNotFound
+	}
+
+	return revocation, nil
+}
+
+// DeleteExpiredRevocations reads every revocation file, skipping the temporary files of atomicfile.
+func (r *Repository) DeleteExpiredRevocations(ctx context.Context, now time.Time) (int, error) {
+	entries, err := os.ReadDir(r.path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list revocations: %w", err)
+	}
+
+	var deleted int
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") || !entry.Type().IsRegular() {
+			continue
+		}
+
+		revocation, err := r.read(entry.Name())
+		if err != nil {
+			if errors.Is(err, revocationrepository.ErrRevocationNotFound) {
+				continue
+			}
+
+			return deleted, err
+		}
+
+		if !revocation.IsExpired(now) {
+			continue
+		}
+
+		err = os.Remove(r.filePath(entry.Name()))
+		if err != nil && !os.IsNotExist(err) {
+			return deleted, fmt.Errorf("failed to delete revocation: %w", err)
+		}
+
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (r *Repository) read(tokenID string) (*domain.Revocation, error) {
+	data, err := os.ReadFile(filepath.Clean(r.filePath(tokenID)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, revocationrepository.ErrRevocationNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read revocation: %w", err)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode revocation: %w", err)
+	}
+
+	return domain.NewRevocation(tokenID, expiresAt), nil
+}
+
+func (r *Repository) filePath(tokenID string) string {
+	return filepath.Join(r.path, filepath.Base(tokenID))
+}
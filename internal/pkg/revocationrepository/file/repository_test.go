@@ -0,0 +1,24 @@
+package file_test
+
+import (
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/file"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(t *testing.T) revocationrepository.Repository {
+		t.Helper()
+
+		repo, err := file.NewRepository(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return repo
+	})
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+)
+
+var _ revocationrepository.Repository = (*Repository)(nil)
+
+type Repository struct {
+	mu          sync.Mutex
+	revocations map[string]*domain.Revocation
+}
+
+func NewRepository() *Repository {
+	return &Repository{
+		mu:          sync.Mutex{},
+		revocations: make(map[string]*domain.Revocation),
+	}
+}
+
+func (r *Repository) CreateRevocation(ctx context.Context, revocation *domain.Revocation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.revocations[revocation.TokenID()]; ok {
+		return revocationrepository.ErrRevocationAlreadyExists
+	}
+
+	r.revocations[revocation.TokenID()] = revocation
+
+	return nil
+}
+
+func (r *Repository) GetRevocation(ctx context.Context, tokenID string, now time.Time) (*domain.Revocation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	revocation, ok := r.revocations[tokenID]
+	if !ok {
+		return nil, revocationrepository.ErrRevocationNotFound
+	}
+
+	if revocation.IsExpired(now) {
+		delete(r.revocations, tokenID)
+
+		return nil, revocationrepository.ErrRevocationNotFound
+	}
+
+	return revocation, nil
+}
+
+func (r *Repository) DeleteExpiredRevocations(ctx context.Context, now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int
+
+	for tokenID, revocation := range r.revocations {
+		if revocation.IsExpired(now) {
+			delete(r.revocations, tokenID)
+
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,17 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/memory"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(_ *testing.T) revocationrepository.Repository {
+		return memory.NewRepository()
+	})
+}
@@ -0,0 +1,14 @@
+package postgres
+
+// migrationsTable records the applied migrations of the revocations table.
+const migrationsTable = "revocation_migrations"
+
+// migrations are applied in order by postgresdb.Migrate.
+// Never edit a released migration, append a new one instead.
+var migrations = []string{ //nolint:gochecknoglobals
+	`CREATE TABLE revocations (
+		token_id   TEXT PRIMARY KEY,
+		expires_at TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX revocations_expires_at ON revocations (expires_at)`,
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+)
+
+var _ revocationrepository.Repository = (*Repository)(nil)
+
+// Repository stores revocations in PostgreSQL, so a token revoked on one replica is rejected by all of them.
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRepository stores revocations in the database of pool and migrates their table.
+// The pool stays open, it belongs to the caller, see postgresdb.Open.
+func NewRepository(ctx context.Context, pool *pgxpool.Pool) (*Repository, error) {
+	err := postgresdb.Migrate(ctx, pool, migrationsTable, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate revocations: %w", err)
+	}
+
+	return &Repository{pool: pool}, nil
+}
+
+func (r *Repository) CreateRevocation(ctx context.Context, revocation *domain.Revocation) error {
+	_, err := r.pool.Exec(ctx, "INSERT INTO revocations (token_id, expires_at) VALUES ($1, $2)",
+		revocation.TokenID(), revocation.ExpiresAt())
+	if err != nil {
+		if postgresdb.IsUniqueViolation(err) {
+			return revocationrepository.ErrRevocationAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create revocation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetRevocation(ctx context.Context, tokenID string, now time.Time) (*domain.Revocation, error) {
+	var expiresAt time.Time
+
+	err := r.pool.QueryRow(ctx, "SELECT expires_at FROM revocations WHERE token_id = $1 AND expires_at >= $2",
+		tokenID, now).Scan(&expiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, revocationrepository.ErrRevocationNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read revocation: %w", err)
+	}
+
+	return domain.NewRevocation(tokenID, expiresAt), nil
+}
+
+func (r *Repository) DeleteExpiredRevocations(ctx context.Context, now time.Time) (int, error) {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM revocations WHERE expires_at < $1", now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired revocations: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,16 @@
+package revocationrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+type Repository interface {
+	CreateRevocation(ctx context.Context, revocation *domain.Revocation) error
+	// GetRevocation returns ErrRevocationNotFound for unknown tokens and for revocations expired at now.
+	GetRevocation(ctx context.Context, tokenID string, now time.Time) (*domain.Revocation, error)
+	// DeleteExpiredRevocations deletes the revocations expired at now and returns how many it deleted.
+	DeleteExpiredRevocations(ctx context.Context, now time.Time) (int, error)
+}
@@ -0,0 +1,177 @@
+package repositorytest
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/revocationrepository"
+)
+
+// Check verifies one part of the revocationrepository.Repository contract.
+// Checks use their own token IDs, so they can share a repository.
+// The IDs start with a random prefix, so a persistent repository can be verified again.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context, repo revocationrepository.Repository) error
+}
+
+// Checks returns the whole contract every revocationrepository.Repository must fulfil.
+// Every call draws a new prefix.
+func Checks() []Check {
+	prefix := rand.Text() + "-"
+	prefixed := func(
+		run func(ctx context.Context, repo revocationrepository.Repository, prefix string) error,
+	) func(ctx context.Context, repo revocationrepository.Repository) error {
+		return func(ctx context.Context, repo revocationrepository.Repository) error {
+			return run(ctx, repo, prefix)
+		}
+	}
+
+	return []Check{
+		{Name: "create and get", Run: prefixed(checkCreateGet)},
+		{Name: "get missing", Run: prefixed(checkGetMissing)},
+		{Name: "create duplicate", Run: prefixed(checkCreateDuplicate)},
+		{Name: "get expired", Run: prefixed(checkGetExpired)},
+		{Name: "delete expired", Run: prefixed(checkDeleteExpired)},
+	}
+}
+
+// Verify runs every check against repo.
+func Verify(ctx context.Context, repo revocationrepository.Repository) error {
+	var errs []error
+
+	for _, check := range Checks() {
+		err := check.Run(ctx, repo)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", check.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run runs every check as a subtest against a fresh repository, like the one of credentialrepository.
+func Run(t *testing.T, newRepository func(t *testing.T) revocationrepository.Repository) {
+	t.Helper()
+
+	for _, check := range Checks() {
+		t.Run(check.Name, func(t *testing.T) {
+			err := check.Run(t.Context(), newRepository(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// newRevocation returns a revocation that expires in ttl, in whole seconds, the precision every backend keeps.
+func newRevocation(tokenID string, ttl time.Duration) *domain.Revocation {
+	return domain.NewRevocation(tokenID, time.Now().Truncate(time.Second).Add(ttl))
+}
+
+func checkCreateGet(ctx context.Context, repo revocationrepository.Repository, prefix string) error {
+	revocation := newRevocation(prefix+"create", time.Hour)
+
+	err := repo.CreateRevocation(ctx, revocation)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	return expectRevocation(ctx, repo, revocation)
+}
+
+func checkGetMissing(ctx context.Context, repo revocationrepository.Repository, prefix string) error {
+	_, err := repo.GetRevocation(ctx, prefix+"missing", time.Now())
+
+	return expectError(err, revocationrepository.ErrRevocationNotFound)
+}
+
+func checkCreateDuplicate(ctx context.Context, repo revocationrepository.Repository, prefix string) error {
+	revocation := newRevocation(prefix+"duplicate", time.Hour)
+
+	err := repo.CreateRevocation(ctx, revocation)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	err = expectError(repo.CreateRevocation(ctx, newRevocation(revocation.TokenID(), 2*time.Hour)),
+		revocationrepository.ErrRevocationAlreadyExists)
+	if err != nil {
+		return err
+	}
+
+	// the duplicate must not replace the revocation
+	return expectRevocation(ctx, repo, revocation)
+}
+
+func checkGetExpired(ctx context.Context, repo revocationrepository.Repository, prefix string) error {
+	revocation := newRevocation(prefix+"expired", time.Hour)
+
+	err := repo.CreateRevocation(ctx, revocation)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	_, err = repo.GetRevocation(ctx, revocation.TokenID(), revocation.ExpiresAt().Add(time.Second))
+
+	return expectError(err, revocationrepository.ErrRevocationNotFound)
+}
+
+func checkDeleteExpired(ctx context.Context, repo revocationrepository.Repository, prefix string) error {
+	expired := newRevocation(prefix+"delete-expired", -time.Hour)
+	live := newRevocation(prefix+"delete-live", time.Hour)
+
+	for _, revocation := range []*domain.Revocation{expired, live} {
+		err := repo.CreateRevocation(ctx, revocation)
+		if err != nil {
+			return fmt.Errorf("create: %w", err)
+		}
+	}
+
+	// other checks may share the repository and leave expired revocations, so at least this one is deleted
+	deleted, err := repo.DeleteExpiredRevocations(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("delete expired: %w", err)
+	}
+
+	if deleted < 1 {
+		return fmt.Errorf("%w: got %d deleted revocations, want at least 1", ErrContractViolated, deleted)
+	}
+
+	// the expired revocation is gone even for a time it was valid at
+	_, err = repo.GetRevocation(ctx, expired.TokenID(), expired.ExpiresAt().Add(-time.Minute))
+
+	err = expectError(err, revocationrepository.ErrRevocationNotFound)
+	if err != nil {
+		return fmt.Errorf("get expired: %w", err)
+	}
+
+	return expectRevocation(ctx, repo, live)
+}
+
+func expectRevocation(ctx context.Context, repo revocationrepository.Repository, want *domain.Revocation) error {
+	got, err := repo.GetRevocation(ctx, want.TokenID(), time.Now())
+	if err != nil {
+		return fmt.Errorf("get %q: %w", want.TokenID(), err)
+	}
+
+	if got.TokenID() != want.TokenID() || !got.ExpiresAt().Equal(want.ExpiresAt()) {
+		return fmt.Errorf("%w: got %s expiring at %v, want %s expiring at %v", ErrContractViolated,
+			got.TokenID(), got.ExpiresAt(), want.TokenID(), want.ExpiresAt())
+	}
+
+	return nil
+}
+
+func expectError(err, want error) error {
+	if !errors.Is(err, want) {
+		return fmt.Errorf("%w: got error %v, want %v", ErrContractViolated, err, want)
+	}
+
+	return nil
+}
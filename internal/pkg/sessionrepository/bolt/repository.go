@@ -0,0 +1,308 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ sessionrepository.Repository = (*Repository)(nil)
+
+var (
+	bucketSessions = []byte("sessions") //nolint:gochecknoglobals
+	bucketIDs      = []byte("ids")      //nolint:gochecknoglobals
+	bucketUsers    = []byte("users")    //nolint:gochecknoglobals
+)
+
+// Repository stores sessions in the "sessions" bucket of a shared bbolt file. Its "ids" bucket maps session IDs
+// to JSON records, its "users" bucket holds a bucket per user with the IDs of the sessions of the user,
+// so listing the sessions of a user reads only theirs.
+type Repository struct {
+	db *bolt.DB
+}
+
+type record struct {
+	Username       string    `json:"username"`
+	ClientID       string    `json:"client_id,omitempty"`
+	RefreshTokenID string    `json:"refresh_token_id"`
+	Audience       []string  `json:"audience,omitempty"`
+	Scope          []string  `json:"scope,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// NewRepository uses db, usually opened by boltdb.Open. The caller keeps ownership of db.
+func NewRepository(db *bolt.DB) (*Repository, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		sessions, err := tx.CreateBucketIfNotExists(bucketSessions)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		_, err = sessions.CreateBucketIfNotExists(bucketIDs)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		_, err = sessions.CreateBucketIfNotExists(bucketUsers)
+
+		return err //nolint:wrapcheck
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sessions bucket: %w", err)
+	}
+
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) CreateSession(ctx context.Context, session *domain.Session) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		if ids(tx).Get([]byte(session.ID())) != nil {
+			return sessionrepository.ErrSessionAlreadyExists
+		}
+
+		return put(tx, session)
+	})
+}
+
+func (r *Repository) DeleteSession(ctx context.Context, session *domain.Session) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		// the stored session knows the user, the given one may be a stale copy
+		stored, err := get(tx, session.ID())
+		if err != nil {
+			return err
+		}
+
+		return remove(tx, stored)
+	})
+}
+
+func (r *Repository) GetSession(ctx context.Context, id string) (*domain.Session, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var session *domain.Session
+
+	err = r.db.View(func(tx *bolt.Tx) error {
+		session, err = get(tx, id)
+
+		return err
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return session, nil
+}
+
+func (r *Repository) ListSessions(ctx context.Context, username string) ([]*domain.Session, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var sessions []*domain.Session
+
+	err = r.db.View(func(tx *bolt.Tx) error {
+		user := users(tx).Bucket([]byte(username))
+		if user == nil {
+			return nil
+		}
+
+		return user.ForEach(func(id, _ []byte) error {
+			session, err := get(tx, string(id))
+			if err != nil {
+				return err
+			}
+
+			sessions = append(sessions, session)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return sessions, nil
+}
+
+// UpdateSession compares the refresh token in the transaction that replaces the session,
+// bbolt runs one writing transaction at a time.
+func (r *Repository) UpdateSession(ctx context.Context, session *domain.Session, refreshTokenID string) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		stored, err := get(tx, session.ID())
+		if err != nil {
+			return err
+		}
+
+		if stored.RefreshTokenID() != refreshTokenID {
+			return sessionrepository.ErrSessionConflict
+		}
+
+		if stored.Username() != session.Username() {
+			err = remove(tx, stored)
+			if err != nil {
+				return err
+			}
+		}
+
+		return put(tx, session)
+	})
+}
+
+func (r *Repository) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	err := ctx.Err()
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	var deleted int
+
+	err = r.db.Update(func(tx *bolt.Tx) error {
+		var expired []*domain.Session
+
+		err := ids(tx).ForEach(func(id, _ []byte) error {
+			session, err := get(tx, string(id))
+			if err != nil {
+				return err
+			}
+
+			if session.IsExpired(now) {
+				expired = append(expired, session)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// a bucket must not be changed while it is iterated
+		for _, session := range expired {
+			err := remove(tx, session)
+			if err != nil {
+				return err
+			}
+		}
+
+		deleted = len(expired)
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	return deleted, nil
+}
+
+func ids(tx *bolt.Tx) *bolt.Bucket {
+	return tx.Bucket(bucketSessions).Bucket(bucketIDs)
+}
+
+func users(tx *bolt.Tx) *bolt.Bucket {
+	return tx.Bucket(bucketSessions).Bucket(bucketUsers)
+}
+
+func get(tx *bolt.Tx, id string) (*domain.Session, error) {
+	data := ids(tx).Get([]byte(id))
+	if data == nil {
+		return nil, sessionrepository.ErrSessionNotFound
+	}
+
+	// data is only valid during the transaction, decoding copies it
+	var rec record
+
+	err := json.Unmarshal(data, &rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode session: %w", err)
+	}
+
+	return domain.NewSession(id, rec.Username, rec.ClientID, rec.RefreshTokenID, rec.CreatedAt, rec.ExpiresAt).
+		WithGrant(rec.Audience, rec.Scope), nil
+}
+
+// put stores the session and indexes it under its user.
+func put(tx *bolt.Tx, session *domain.Session) error {
+	data, err := json.Marshal(&record{
+		Username:       session.Username(),
+		ClientID:       session.ClientID(),
+		RefreshTokenID: session.RefreshTokenID(),
+		Audience:       session.Audience(),
+		Scope:          session.Scope(),
+		CreatedAt:      session.CreatedAt(),
+		ExpiresAt:      session.ExpiresAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode session: %w", err)
+	}
+
+	err = ids(tx).Put([]byte(session.ID()), data)
+	if err != nil {
+		return fmt.Errorf("failed to store session: %w", err)
+	}
+
+	user, err := users(tx).CreateBucketIfNotExists([]byte(session.Username()))
+	if err != nil {
+		return fmt.Errorf("failed to index session: %w", err)
+	}
+
+	err = user.Put([]byte(session.ID()), nil)
+	if err != nil {
+		return fmt.Errorf("failed to index session: %w", err)
+	}
+
+	return nil
+}
+
+// remove deletes a stored session and its index entry, and the bucket of its user once it is empty.
+func remove(tx *bolt.Tx, session *domain.Session) error {
+	err := ids(tx).Delete([]byte(session.ID()))
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	user := users(tx).Bucket([]byte(session.Username()))
+	if user == nil {
+		return nil
+	}
+
+	err = user.Delete([]byte(session.ID()))
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	if first, _ := user.Cursor().First(); first != nil {
+		return nil
+	}
+
+	err = users(tx).DeleteBucket([]byte(session.Username()))
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	return nil
+}
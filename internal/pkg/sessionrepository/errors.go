@@ -0,0 +1,9 @@
+package sessionrepository
+
+import "errors"
+
+var (
+	ErrSessionNotFound      = errors.New("session not found")
+	ErrSessionAlreadyExists = errors.New("session already exists")
+	ErrSessionConflict      = errors.New("session conflict")
+)
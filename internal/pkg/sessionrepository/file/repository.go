@@ -0,0 +1,268 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/atomicfile"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
+)
+
+var _ sessionrepository.Repository = (*Repository)(nil)
+
+// Repository stores one file per session, named by the session ID.
+// The sessions of each user are indexed in memory, built from the files when the repository is created,
+// so listing the sessions of a user reads only their files. The directory must not be shared by processes.
+type Repository struct {
+	mu   sync.Mutex
+	path string
+	// users maps usernames to the IDs of their sessions and the expiry of each
+	users map[string]map[string]time.Time
+}
+
+type record struct {
+	ID             string    `json:"id"`
+	Username       string    `json:"username"`
+	ClientID       string    `json:"client_id,omitempty"`
+	RefreshTokenID string    `json:"refresh_token_id"`
+	Audience       []string  `json:"audience,omitempty"`
+	Scope          []string  `json:"scope,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+func NewRepository(path string) (*Repository, error) {
+	const createPerm = 0750
+
+	err := os.MkdirAll(path, createPerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	repo := &Repository{mu: sync.Mutex{}, path: path, users: make(map[string]map[string]time.Time)}
+
+	ids, err := repo.listIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		session, err := repo.read(id)
+		if err != nil {
+			return nil, err
+		}
+
+		repo.index(session)
+	}
+
+	return repo, nil
+}
+
+func (r *Repository) CreateSession(ctx context.Context, session *domain.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	err := r.write(session, false)
+	if err != nil {
+		if os.IsExist(err) {
+			return sessionrepository.ErrSessionAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	r.index(session)
+
+	return nil
+}
+
+func (r *Repository) DeleteSession(ctx context.Context, session *domain.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// the stored session knows the user, the given one may be a stale copy
+	stored, err := r.read(session.ID())
+	if err != nil {
+		return err
+	}
+
+	return r.delete(stored.ID(), stored.Username())
+}
+
+func (r *Repository) GetSession(ctx context.Context, id string) (*domain.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.read(id)
+}
+
+func (r *Repository) ListSessions(ctx context.Context, username string) ([]*domain.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ret []*domain.Session
+
+	for id := range r.users[username] {
+		session, err := r.read(id)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, session)
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) UpdateSession(ctx context.Context, session *domain.Session, refreshTokenID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, err := r.read(session.ID())
+	if err != nil {
+		return err
+	}
+
+	if stored.RefreshTokenID() != refreshTokenID {
+		return sessionrepository.ErrSessionConflict
+	}
+
+	err = r.write(session, true)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	r.index(session)
+
+	return nil
+}
+
+func (r *Repository) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int
+
+	for username, sessions := range r.users {
+		for id, expiresAt := range sessions {
+			if !now.After(expiresAt) {
+				continue
+			}
+
+			err := r.delete(id, username)
+			if err != nil && !errors.Is(err, sessionrepository.ErrSessionNotFound) {
+				return deleted, err
+			}
+
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+// listIDs lists the IDs of the stored sessions, skipping temporary files.
+func (r *Repository) listIDs() ([]string, error) {
+	entries, err := os.ReadDir(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var ids []string
+
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ".json")
+		if ok && entry.Type().IsRegular() {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *Repository) index(session *domain.Session) {
+	sessions, ok := r.users[session.Username()]
+	if !ok {
+		sessions = make(map[string]time.Time)
+		r.users[session.Username()] = sessions
+	}
+
+	sessions[session.ID()] = session.ExpiresAt()
+}
+
+// delete removes the file and the index entry of a session.
+func (r *Repository) delete(id, username string) error {
+	err := os.Remove(r.filePath(id))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	sessions := r.users[username]
+	delete(sessions, id)
+
+	if len(sessions) == 0 {
+		delete(r.users, username)
+	}
+
+	if err != nil {
+		return sessionrepository.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) filePath(id string) string {
+	return filepath.Join(r.path, filepath.Base(id)+".json")
+}
+
+func (r *Repository) read(id string) (*domain.Session, error) {
+	data, err := os.ReadFile(filepath.Clean(r.filePath(id)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, sessionrepository.ErrSessionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read session: %w", err)
+	}
+
+	var rec record
+
+	err = json.Unmarshal(data, &rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode session: %w", err)
+	}
+
+	return domain.NewSession(
+		rec.ID, rec.Username, rec.ClientID, rec.RefreshTokenID, rec.CreatedAt, rec.ExpiresAt,
+	).WithGrant(rec.Audience, rec.Scope), nil
+}
+
+func (r *Repository) write(session *domain.Session, overwrite bool) error {
+	data, err := json.Marshal(&record{
+		ID:             session.ID(),
+		Username:       session.Username(),
+		ClientID:       session.ClientID(),
+		RefreshTokenID: session.RefreshTokenID(),
+		Audience:       session.Audience(),
+		Scope:          session.Scope(),
+		CreatedAt:      session.CreatedAt(),
+		ExpiresAt:      session.ExpiresAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode session: %w", err)
+	}
+
+	if overwrite {
+		return atomicfile.Write(r.filePath(session.ID()), data) //nolint:wrapcheck
+	}
+
+	return atomicfile.Create(r.filePath(session.ID()), data) //nolint:wrapcheck
+}
@@ -0,0 +1,24 @@
+package file_test
+
+import (
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/file"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(t *testing.T) sessionrepository.Repository {
+		t.Helper()
+
+		repo, err := file.NewRepository(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return repo
+	})
+}
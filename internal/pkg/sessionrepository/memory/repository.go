@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
+)
+
+var _ sessionrepository.Repository = (*Repository)(nil)
+
+type Repository struct {
+	mu       sync.Mutex
+	sessions map[string]*domain.Session
+}
+
+func NewRepository() *Repository {
+	return &Repository{
+		mu:       sync.Mutex{},
+		sessions: make(map[string]*domain.Session),
+	}
+}
+
+func (r *Repository) CreateSession(ctx context.Context, session *domain.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[session.ID()]; ok {
+		return sessionrepository.ErrSessionAlreadyExists
+	}
+
+	r.sessions[session.ID()] = session
+
+	return nil
+}
+
+func (r *Repository) DeleteSession(ctx context.Context, session *domain.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[session.ID()]; !ok {
+		return sessionrepository.ErrSessionNotFound
+	}
+
+	delete(r.sessions, session.ID())
+
+	return nil
+}
+
+func (r *Repository) GetSession(ctx context.Context, id string) (*domain.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, sessionrepository.ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (r *Repository) ListSessions(ctx context.Context, username string) ([]*domain.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ret []*domain.Session
+
+	for _, session := range r.sessions {
+		if session.Username() == username {
+			ret = append(ret, session)
+		}
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) UpdateSession(ctx context.Context, session *domain.Session, refreshTokenID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.sessions[session.ID()]
+	if !ok {
+		return sessionrepository.ErrSessionNotFound
+	}
+
+	if stored.RefreshTokenID() != refreshTokenID {
+		return sessionrepository.ErrSessionConflict
+	}
+
+	r.sessions[session.ID()] = session
+
+	return nil
+}
+
+func (r *Repository) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int
+
+	for id, session := range r.sessions {
+		if session.IsExpired(now) {
+			delete(r.sessions, id)
+
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,17 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/memory"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(_ *testing.T) sessionrepository.Repository {
+		return memory.NewRepository()
+	})
+}
@@ -0,0 +1,21 @@
+package postgres
+
+// migrationsTable records the applied migrations of the sessions table.
+const migrationsTable = "session_migrations"
+
+// migrations are applied in order by postgresdb.Migrate.
+// Never edit a released migration, append a new one instead.
+var migrations = []string{ //nolint:gochecknoglobals
+	`CREATE TABLE sessions (
+		id               TEXT PRIMARY KEY,
+		username         TEXT NOT NULL,
+		client_id        TEXT NOT NULL,
+		refresh_token_id TEXT NOT NULL,
+		audience         TEXT[] NOT NULL,
+		scope            TEXT[] NOT NULL,
+		created_at       TIMESTAMPTZ NOT NULL,
+		expires_at       TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX sessions_username ON sessions (username)`,
+	`CREATE INDEX sessions_expires_at ON sessions (expires_at)`,
+}
@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
+)
+
+var _ sessionrepository.Repository = (*Repository)(nil)
+
+// Repository stores sessions in PostgreSQL, so a session started on one replica can be refreshed on another.
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRepository stores sessions in the database of pool and migrates their table.
+// The pool stays open, it belongs to the caller, see postgresdb.Open.
+func NewRepository(ctx context.Context, pool *pgxpool.Pool) (*Repository, error) {
+	err := postgresdb.Migrate(ctx, pool, migrationsTable, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate sessions: %w", err)
+	}
+
+	return &Repository{pool: pool}, nil
+}
+
+// sessionColumns are read by scanSession, in its order.
+const sessionColumns = "id, username, client_id, refresh_token_id, audience, scope, created_at, expires_at"
+
+func (r *Repository) CreateSession(ctx context.Context, session *domain.Session) error {
+	_, err := r.pool.Exec(ctx,
+		"INSERT INTO sessions ("+sessionColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		session.ID(), session.Username(), session.ClientID(), session.RefreshTokenID(),
+		nonNil(session.Audience()), nonNil(session.Scope()), session.CreatedAt(), session.ExpiresAt(),
+	)
+	if err != nil {
+		if postgresdb.IsUniqueViolation(err) {
+			return sessionrepository.ErrSessionAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteSession(ctx context.Context, session *domain.Session) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM sessions WHERE id = $1", session.ID())
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return sessionrepository.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) GetSession(ctx context.Context, id string) (*domain.Session, error) {
+	session, err := scanSession(r.pool.QueryRow(ctx, "SELECT "+sessionColumns+" FROM sessions WHERE id = $1", id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, sessionrepository.ErrSessionNotFound
+		}
+
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r *Repository) ListSessions(ctx context.Context, username string) ([]*domain.Session, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+sessionColumns+" FROM sessions WHERE username = $1 ORDER BY created_at", username,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*domain.Session
+
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// UpdateSession compares the refresh token in the same statement that replaces the session,
+// so of two replicas refreshing the same token only one matches.
+func (r *Repository) UpdateSession(ctx context.Context, session *domain.Session, refreshTokenID string) error {
+	tag, err := r.pool.Exec(ctx,
+		"UPDATE sessions SET username = $1, client_id = $2, refresh_token_id = $3, audience = $4, scope = $5, "+
+			"created_at = $6, expires_at = $7 WHERE id = $8 AND refresh_token_id = $9",
+		session.Username(), session.ClientID(), session.RefreshTokenID(), nonNil(session.Audience()),
+		nonNil(session.Scope()), session.CreatedAt(), session.ExpiresAt(), session.ID(), refreshTokenID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	if tag.RowsAffected() == 1 {
+		return nil
+	}
+
+	var exists bool
+
+	err = r.pool.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM sessions WHERE id = $1)", session.ID()).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check session: %w", err)
+	}
+
+	if !exists {
+		return sessionrepository.ErrSessionNotFound
+	}
+
+	return sessionrepository.ErrSessionConflict
+}
+
+func (r *Repository) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM sessions WHERE expires_at < $1", now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// scanSession reads the sessionColumns of a pgx.Row or the current row of pgx.Rows.
+func scanSession(row pgx.Row) (*domain.Session, error) {
+	var (
+		id             string
+		username       string
+		clientID       string
+		refreshTokenID string
+		audience       []string
+		scope          []string
+		createdAt      time.Time
+		expiresAt      time.Time
+	)
+
+	err := row.Scan(&id, &username, &clientID, &refreshTokenID, &audience, &scope, &createdAt, &expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session: %w", err)
+	}
+
+	return domain.NewSession(id, username, clientID, refreshTokenID, createdAt, expiresAt).
+		WithGrant(audience, scope), nil
+}
+
+// nonNil stores a missing audience or scope as an empty array instead of NULL.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
@@ -0,0 +1,40 @@
+package postgres_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/postgres"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository/repositorytest"
+)
+
+// TestConformance needs a database in KS_REPOSITORY_DSN, e.g. the one make postgres starts.
+// The checks use random session IDs and usernames, so they can run against the same database again.
+func TestConformance(t *testing.T) {
+	dsn := os.Getenv("KS_REPOSITORY_DSN")
+	if dsn == "" {
+		t.Skip("KS_REPOSITORY_DSN is not set")
+	}
+
+	t.Parallel()
+
+	repositorytest.Run(t, func(t *testing.T) sessionrepository.Repository {
+		t.Helper()
+
+		pool, err := postgresdb.Open(t.Context(), dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Cleanup(pool.Close)
+
+		repo, err := postgres.NewRepository(t.Context(), pool)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return repo
+	})
+}
@@ -0,0 +1,20 @@
+package sessionrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+type Repository interface {
+	CreateSession(ctx context.Context, session *domain.Session) error
+	DeleteSession(ctx context.Context, session *domain.Session) error
+	GetSession(ctx context.Context, id string) (*domain.Session, error)
+	ListSessions(ctx context.Context, username string) ([]*domain.Session, error)
+	// UpdateSession replaces the stored session only if its refresh token is still refreshTokenID.
+	// Returns ErrSessionConflict otherwise, so two refreshes can never both consume the same token.
+	UpdateSession(ctx context.Context, session *domain.Session, refreshTokenID string) error
+	// DeleteExpiredSessions deletes the sessions expired at now and returns how many it deleted.
+	DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error)
+}
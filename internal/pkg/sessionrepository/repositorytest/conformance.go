@@ -0,0 +1,365 @@
+package repositorytest
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/sessionrepository"
+)
+
+// Check verifies one part of the sessionrepository.Repository contract.
+// Checks use their own session IDs and usernames, so they can share a repository.
+// Both start with a random prefix, so a persistent repository can be verified again.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context, repo sessionrepository.Repository) error
+}
+
+// Checks returns the whole contract every sessionrepository.Repository must fulfil.
+// Every call draws a new prefix.
+func Checks() []Check {
+	prefix := rand.Text() + "-"
+	prefixed := func(
+		run func(ctx context.Context, repo sessionrepository.Repository, prefix string) error,
+	) func(ctx context.Context, repo sessionrepository.Repository) error {
+		return func(ctx context.Context, repo sessionrepository.Repository) error {
+			return run(ctx, repo, prefix)
+		}
+	}
+
+	return []Check{
+		{Name: "create and get", Run: prefixed(checkCreateGet)},
+		{Name: "get missing", Run: prefixed(checkGetMissing)},
+		{Name: "create duplicate", Run: prefixed(checkCreateDuplicate)},
+		{Name: "delete", Run: prefixed(checkDelete)},
+		{Name: "list", Run: prefixed(checkList)},
+		{Name: "update", Run: prefixed(checkUpdate)},
+		{Name: "concurrent update", Run: prefixed(checkConcurrentUpdate)},
+		{Name: "delete expired", Run: prefixed(checkDeleteExpired)},
+	}
+}
+
+// Verify runs every check against repo.
+func Verify(ctx context.Context, repo sessionrepository.Repository) error {
+	var errs []error
+
+	for _, check := range Checks() {
+		err := check.Run(ctx, repo)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", check.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run runs every check as a subtest against a fresh repository, like the one of credentialrepository.
+func Run(t *testing.T, newRepository func(t *testing.T) sessionrepository.Repository) {
+	t.Helper()
+
+	for _, check := range Checks() {
+		t.Run(check.Name, func(t *testing.T) {
+			err := check.Run(t.Context(), newRepository(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// newSession returns a session of the user that expires in ttl, with times in whole seconds,
+// the precision every backend keeps.
+func newSession(id, username string, ttl time.Duration) *domain.Session {
+	now := time.Now().Truncate(time.Second)
+
+	return domain.NewSession(id, username, "client", id+"-refresh", now, now.Add(ttl)).
+		WithGrant([]string{"key-stone", "billing"}, []string{"read"})
+}
+
+func checkCreateGet(ctx context.Context, repo sessionrepository.Repository, prefix string) error {
+	session := newSession(prefix+"create", prefix+"alice", time.Hour)
+
+	err := repo.CreateSession(ctx, session)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	// a session without client and grant is stored as well
+	now := time.Now().Truncate(time.Second)
+	bare := domain.NewSession(prefix+"create-bare", prefix+"alice", "", prefix+"bare-refresh", now, now.Add(time.Hour))
+
+	err = repo.CreateSession(ctx, bare)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	err = expectSession(ctx, repo, session)
+	if err != nil {
+		return err
+	}
+
+	return expectSession(ctx, repo, bare)
+}
+
+func checkGetMissing(ctx context.Context, repo sessionrepository.Repository, prefix string) error {
+	_, err := repo.GetSession(ctx, prefix+"missing")
+
+	return expectError(err, sessionrepository.ErrSessionNotFound)
+}
+
+func checkCreateDuplicate(ctx context.Context, repo sessionrepository.Repository, prefix string) error {
+	session := newSession(prefix+"duplicate", prefix+"alice", time.Hour)
+
+	err := repo.CreateSession(ctx, session)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	err = expectError(repo.CreateSession(ctx, newSession(session.ID(), prefix+"bob", time.Hour)),
+		sessionrepository.ErrSessionAlreadyExists)
+	if err != nil {
+		return err
+	}
+
+	// the duplicate must not replace the session
+	return expectSession(ctx, repo, session)
+}
+
+func checkDelete(ctx context.Context, repo sessionrepository.Repository, prefix string) error {
+	session := newSession(prefix+"delete", prefix+"alice", time.Hour)
+
+	err := repo.CreateSession(ctx, session)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	err = repo.DeleteSession(ctx, session)
+	if err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	_, err = repo.GetSession(ctx, session.ID())
+
+	err = expectError(err, sessionrepository.ErrSessionNotFound)
+	if err != nil {
+		return fmt.Errorf("get deleted: %w", err)
+	}
+
+	sessions, err := repo.ListSessions(ctx, session.Username())
+	if err != nil {
+		return fmt.Errorf("list: %w", err)
+	}
+
+	if len(sessions) != 0 {
+		return fmt.Errorf("%w: got %d sessions of a user without sessions", ErrContractViolated, len(sessions))
+	}
+
+	return expectError(repo.DeleteSession(ctx, session), sessionrepository.ErrSessionNotFound)
+}
+
+func checkList(ctx context.Context, repo sessionrepository.Repository, prefix string) error {
+	alice := []*domain.Session{
+		newSession(prefix+"list-1", prefix+"alice", time.Hour),
+		newSession(prefix+"list-2", prefix+"alice", 2*time.Hour),
+	}
+	bob := newSession(prefix+"list-3", prefix+"bob", time.Hour)
+
+	for _, session := range append(slices.Clone(alice), bob) {
+		err := repo.CreateSession(ctx, session)
+		if err != nil {
+			return fmt.Errorf("create: %w", err)
+		}
+	}
+
+	err := expectList(ctx, repo, prefix+"alice", alice)
+	if err != nil {
+		return err
+	}
+
+	err = expectList(ctx, repo, prefix+"bob", []*domain.Session{bob})
+	if err != nil {
+		return err
+	}
+
+	return expectList(ctx, repo, prefix+"carol", nil)
+}
+
+func checkUpdate(ctx context.Context, repo sessionrepository.Repository, prefix string) error {
+	session := newSession(prefix+"update", prefix+"alice", time.Hour)
+
+	err := repo.CreateSession(ctx, session)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	rotated := session.Rotate(prefix+"rotated", session.ExpiresAt().Add(time.Hour)).WithGrant(nil, []string{"write"})
+
+	err = repo.UpdateSession(ctx, rotated, session.RefreshTokenID())
+	if err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+
+	err = expectSession(ctx, repo, rotated)
+	if err != nil {
+		return err
+	}
+
+	// the consumed refresh token does not match anymore
+	err = expectError(repo.UpdateSession(ctx, session, session.RefreshTokenID()), sessionrepository.ErrSessionConflict)
+	if err != nil {
+		return fmt.Errorf("update with a consumed refresh token: %w", err)
+	}
+
+	err = expectSession(ctx, repo, rotated)
+	if err != nil {
+		return err
+	}
+
+	missing := newSession(prefix+"update-missing", prefix+"alice", time.Hour)
+
+	err = expectError(repo.UpdateSession(ctx, missing, missing.RefreshTokenID()), sessionrepository.ErrSessionNotFound)
+	if err != nil {
+		return fmt.Errorf("update missing: %w", err)
+	}
+
+	// an update must not create the session
+	_, err = repo.GetSession(ctx, missing.ID())
+
+	return expectError(err, sessionrepository.ErrSessionNotFound)
+}
+
+// checkConcurrentUpdate lets workers consume the same refresh token at once, only one of them may succeed.
+func checkConcurrentUpdate(ctx context.Context, repo sessionrepository.Repository, prefix string) error {
+	const workers = 8
+
+	session := newSession(prefix+"concurrent", prefix+"alice", time.Hour)
+
+	err := repo.CreateSession(ctx, session)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		errs      []error
+	)
+
+	for worker := range workers {
+		wg.Go(func() {
+			rotated := session.Rotate(fmt.Sprintf("%srotated-%d", prefix, worker), session.ExpiresAt())
+
+			err := repo.UpdateSession(ctx, rotated, session.RefreshTokenID())
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case err == nil:
+				succeeded++
+			case !errors.Is(err, sessionrepository.ErrSessionConflict):
+				errs = append(errs, err)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if succeeded != 1 {
+		return fmt.Errorf("%w: %d updates consumed the same refresh token", ErrContractViolated, succeeded)
+	}
+
+	return nil
+}
+
+func checkDeleteExpired(ctx context.Context, repo sessionrepository.Repository, prefix string) error {
+	expired := newSession(prefix+"expired", prefix+"alice", -time.Hour)
+	live := newSession(prefix+"live", prefix+"alice", time.Hour)
+
+	for _, session := range []*domain.Session{expired, live} {
+		err := repo.CreateSession(ctx, session)
+		if err != nil {
+			return fmt.Errorf("create: %w", err)
+		}
+	}
+
+	// other checks may share the repository and leave expired sessions, so at least this one is deleted
+	deleted, err := repo.DeleteExpiredSessions(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("delete expired: %w", err)
+	}
+
+	if deleted < 1 {
+		return fmt.Errorf("%w: got %d deleted sessions, want at least 1", ErrContractViolated, deleted)
+	}
+
+	_, err = repo.GetSession(ctx, expired.ID())
+
+	err = expectError(err, sessionrepository.ErrSessionNotFound)
+	if err != nil {
+		return fmt.Errorf("get expired: %w", err)
+	}
+
+	return expectList(ctx, repo, prefix+"alice", []*domain.Session{live})
+}
+
+func expectSession(ctx context.Context, repo sessionrepository.Repository, want *domain.Session) error {
+	got, err := repo.GetSession(ctx, want.ID())
+	if err != nil {
+		return fmt.Errorf("get %q: %w", want.ID(), err)
+	}
+
+	if !equal(got, want) {
+		return fmt.Errorf("%w: got %+v, want %+v", ErrContractViolated, got, want)
+	}
+
+	return nil
+}
+
+// expectList compares the sessions of the user, in any order.
+func expectList(ctx context.Context, repo sessionrepository.Repository, username string, want []*domain.Session) error {
+	got, err := repo.ListSessions(ctx, username)
+	if err != nil {
+		return fmt.Errorf("list %q: %w", username, err)
+	}
+
+	if len(got) != len(want) {
+		return fmt.Errorf("%w: got %d sessions of %q, want %d", ErrContractViolated, len(got), username, len(want))
+	}
+
+	for _, session := range want {
+		if !slices.ContainsFunc(got, func(got *domain.Session) bool { return equal(got, session) }) {
+			return fmt.Errorf("%w: session %q of %q is not listed", ErrContractViolated, session.ID(), username)
+		}
+	}
+
+	return nil
+}
+
+// equal compares every field, an empty audience or scope equals a missing one.
+func equal(a, b *domain.Session) bool {
+	return a.ID() == b.ID() && a.Username() == b.Username() && a.ClientID() == b.ClientID() &&
+		a.RefreshTokenID() == b.RefreshTokenID() &&
+		slices.Equal(a.Audience(), b.Audience()) && slices.Equal(a.Scope(), b.Scope()) &&
+		a.CreatedAt().Equal(b.CreatedAt()) && a.ExpiresAt().Equal(b.ExpiresAt())
+}
+
+func expectError(err, want error) error {
+	if !errors.Is(err, want) {
+		return fmt.Errorf("%w: got error %v, want %v", ErrContractViolated, err, want)
+	}
+
+	return nil
+}
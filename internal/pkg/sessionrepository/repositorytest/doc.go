@@ -0,0 +1,3 @@
+// Package repositorytest holds the contract every sessionrepository.Repository implementation must fulfil.
+// New backends are verified with Run from a test or with Verify.
+package repositorytest
@@ -1,8 +1,17 @@
 package tokengenerator
 
-import "time"
+import (
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
 
 type Generator interface {
-	GenerateToken(subject string, now time.Time, duration time.Duration) string
-	ParseToken(token string, now time.Time) (string, error)
+	GenerateToken(claims *domain.Claims) (string, error)
+	ParseToken(token string, now time.Time) (*domain.Claims, error)
+	// VerifyToken parses a token like ParseToken and also rejects it unless it was issued for the audience.
+	VerifyToken(token string, now time.Time, audience string) (*domain.Claims, error)
+	// InspectToken verifies the signature of a token like ParseToken, but also accepts expired tokens.
+	InspectToken(token string, now time.Time) (*domain.Claims, error)
+	PublicKeys(now time.Time) []*PublicKey
 }
@@ -0,0 +1,14 @@
+package tokengenerator
+
+// PublicKey is a key that verifies generated tokens, in JSON Web Key form.
+type PublicKey struct {
+	KeyType   string
+	Use       string
+	Algorithm string
+	KeyID     string
+	N         string
+	E         string
+	Curve     string
+	X         string
+	Y         string
+}
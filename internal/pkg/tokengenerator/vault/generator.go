@@ -2,8 +2,11 @@ package vault
 
 import (
 	"errors"
+	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
 	"github.com/neatflowcv/key-stone/internal/pkg/tokengenerator"
 	"github.com/neatflowcv/key-stone/pkg/vault"
 )
@@ -14,19 +17,96 @@ type Generator struct {
 	vault *vault.Vault
 }
 
-func NewGenerator(issuer string, secretKey []byte) *Generator {
-	return &Generator{vault: vault.NewVault(issuer, secretKey)}
+func NewGenerator(issuer string, keys *vault.KeyRing) *Generator {
+	return &Generator{vault: vault.NewVault(issuer, keys)}
 }
 
-func (g *Generator) GenerateToken(subject string, now time.Time, duration time.Duration) string {
-	return g.vault.Encrypt(subject, now, duration)
+func (g *Generator) GenerateToken(claims *domain.Claims) (string, error) {
+	return g.vault.Encrypt(&vault.Claims{ //nolint:wrapcheck
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "",
+			Subject:   claims.Subject(),
+			Audience:  claims.Audience(),
+			ExpiresAt: jwt.NewNumericDate(claims.ExpiresAt()),
+			NotBefore: jwt.NewNumericDate(claims.NotBefore()),
+			IssuedAt:  jwt.NewNumericDate(claims.IssuedAt()),
+			ID:        claims.ID(),
+		},
+		SessionID: claims.SessionID(),
+		ClientID:  claims.ClientID(),
+		Scope:     strings.Join(claims.Scope(), " "),
+		Custom:    claims.CustomClaims(),
+	})
 }
 
-func (g *Generator) ParseToken(encrypted string, now time.Time) (string, error) {
+func (g *Generator) ParseToken(encrypted string, now time.Time) (*domain.Claims, error) {
 	ret, err := g.vault.Decrypt(encrypted, now)
 	if err != nil {
-		return "", errors.Join(err, tokengenerator.ErrTokenInvalid)
+		return nil, errors.Join(err, tokengenerator.ErrTokenInvalid)
+	}
+
+	return toClaims(ret)
+}
+
+func (g *Generator) VerifyToken(encrypted string, now time.Time, audience string) (*domain.Claims, error) {
+	ret, err := g.vault.Verify(encrypted, now, audience)
+	if err != nil {
+		return nil, errors.Join(err, tokengenerator.ErrTokenInvalid)
+	}
+
+	return toClaims(ret)
+}
+
+func (g *Generator) InspectToken(encrypted string, now time.Time) (*domain.Claims, error) {
+	ret, err := g.vault.Inspect(encrypted, now)
+	if err != nil {
+		return nil, errors.Join(err, tokengenerator.ErrTokenInvalid)
+	}
+
+	return toClaims(ret)
+}
+
+func (g *Generator) PublicKeys(now time.Time) []*tokengenerator.PublicKey {
+	var ret []*tokengenerator.PublicKey
+
+	for _, key := range g.vault.PublicKeys(now) {
+		ret = append(ret, &tokengenerator.PublicKey{
+			KeyType:   key.KeyType,
+			Use:       key.Use,
+			Algorithm: key.Algorithm,
+			KeyID:     key.KeyID,
+			N:         key.N,
+			E:         key.E,
+			Curve:     key.Curve,
+			X:         key.X,
+			Y:         key.Y,
+		})
+	}
+
+	return ret
+}
+
+func toClaims(claims *vault.Claims) (*domain.Claims, error) {
+	ret := domain.NewClaims(claims.ID, claims.Subject, toTime(claims.IssuedAt), toTime(claims.ExpiresAt)).
+		WithIssuer(claims.Issuer).
+		WithAudience(claims.Audience...).
+		WithSessionID(claims.SessionID).
+		WithClientID(claims.ClientID).
+		WithScope(claims.Scopes()...).
+		WithNotBefore(toTime(claims.NotBefore))
+
+	ret, err := ret.WithCustomClaims(claims.Custom)
+	if err != nil {
+		return nil, errors.Join(err, tokengenerator.ErrTokenInvalid)
 	}
 
 	return ret, nil
 }
+
+func toTime(date *jwt.NumericDate) time.Time {
+	if date == nil {
+		return time.Time{}
+	}
+
+	return date.Time
+}
@@ -0,0 +1,149 @@
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ verificationtokenrepository.Repository = (*Repository)(nil)
+
+var bucketVerificationTokens = []byte("verification-tokens") //nolint:gochecknoglobals
+
+// Repository stores verification tokens in the "verification-tokens" bucket of a shared bbolt file, keyed by token ID.
+// Deleting the key is what uses the token up, bbolt runs one writing transaction at a time,
+// so only one delete finds it.
+type Repository struct {
+	db *bolt.DB
+}
+
+type record struct {
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewRepository uses db, usually opened by boltdb.Open. The caller keeps ownership of db.
+func NewRepository(db *bolt.DB) (*Repository, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketVerificationTokens)
+
+		return err //nolint:wrapcheck
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create verification-tokens bucket: %w", err)
+	}
+
+	return &Repository{db: db}, nil
+}
+
+func (r *Repository) CreateVerificationToken(ctx context.Context, token *domain.VerificationToken) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	data, err := json.Marshal(&record{
+		Username:  token.Username(),
+		Email:     token.Email(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode verification token: %w", err)
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		bucket := tx.Bucket(bucketVerificationTokens)
+		key := []byte(token.ID())
+
+		if bucket.Get(key) != nil {
+			return verificationtokenrepository.ErrVerificationTokenAlreadyExists
+		}
+
+		err := bucket.Put(key, data)
+		if err != nil {
+			return fmt.Errorf("failed to create verification token: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *Repository) GetVerificationToken(
+	ctx context.Context,
+	id string,
+	now time.Time,
+) (*domain.VerificationToken, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	var token *domain.VerificationToken
+
+	err = r.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketVerificationTokens).Get([]byte(id))
+		if data == nil {
+			return verificationtokenrepository.ErrVerificationTokenNotFound
+		}
+
+		// data is only valid during the transaction, decoding copies it
+		var rec record
+
+		err := json.Unmarshal(data, &rec)
+		if err != nil {
+			return fmt.Errorf("failed to decode verification token: %w", err)
+		}
+
+		token = domain.NewVerificationToken(id, rec.Username, rec.Email, rec.CreatedAt, rec.ExpiresAt)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if token.IsExpired(now) {
+		// nothing else removes expired tokens, so the read does, like the one of the file repository
+		err := r.DeleteVerificationToken(ctx, id)
+		if err != nil && !errors.Is(err, verificationtokenrepository.ErrVerificationTokenNotFound) {
+			log.Printf("failed to remove expired verification token: %v", err)
+		}
+
+		return nil, verificationtokenrepository.ErrVerificationTokenNotFound
+	}
+
+	return token, nil
+}
+
+func (r *Repository) DeleteVerificationToken(ctx context.Context, id string) error {
+	err := ctx.Err()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error { //nolint:wrapcheck
+		bucket := tx.Bucket(bucketVerificationTokens)
+		key := []byte(id)
+
+		if bucket.Get(key) == nil {
+			return verificationtokenrepository.ErrVerificationTokenNotFound
+		}
+
+		err := bucket.Delete(key)
+		if err != nil {
+			return fmt.Errorf("failed to delete verification token: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,8 @@
+package verificationtokenrepository
+
+import "errors"
+
+var (
+	ErrVerificationTokenNotFound      = errors.New("verification token not found")
+	ErrVerificationTokenAlreadyExists = errors.New("verification token already exists")
+)
@@ -0,0 +1,117 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/atomicfile"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository"
+)
+
+var _ verificationtokenrepository.Repository = (*Repository)(nil)
+
+// Repository stores one file per verification token, named by the token ID.
+// Removing the file is what uses the token up, the file system lets only one remove succeed.
+type Repository struct {
+	path string
+}
+
+type record struct {
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewRepository(path string) (*Repository, error) {
+	const createPerm = 0750
+
+	err := os.MkdirAll(path, createPerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	return &Repository{path: path}, nil
+}
+
+func (r *Repository) CreateVerificationToken(ctx context.Context, token *domain.VerificationToken) error {
+	data, err := json.Marshal(&record{
+		Username:  token.Username(),
+		Email:     token.Email(),
+		CreatedAt: token.CreatedAt(),
+		ExpiresAt: token.ExpiresAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode verification token: %w", err)
+	}
+
+	err = atomicfile.Create(r.filePath(token.ID()), data)
+	if err != nil {
+		if os.IsExist(err) {
+			return verificationtokenrepository.ErrVerificationTokenAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetVerificationToken(
+	ctx context.Context,
+	id string,
+	now time.Time,
+) (*domain.VerificationToken, error) {
+	filePath := r.filePath(id)
+
+	data, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, verificationtokenrepository.ErrVerificationTokenNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read verification token: %w", err)
+	}
+
+	var rec record
+
+	err = json.Unmarshal(data, &rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode verification token: %w", err)
+	}
+
+	token := domain.NewVerificationToken(id, rec.Username, rec.Email, rec.CreatedAt, rec.ExpiresAt)
+	if token.IsExpired(now) {
+		err := os.Remove(filePath)
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("failed to remove expired verification token: %v", err)
+		}
+
+		return nil, verificationtokenrepository.ErrVerificationTokenNotFound
+	}
+
+	return token, nil
+}
+
+func (r *Repository) DeleteVerificationToken(ctx context.Context, id string) error {
+	err := os.Remove(r.filePath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return verificationtokenrepository.ErrVerificationTokenNotFound
+		}
+
+		return fmt.Errorf("failed to delete verification token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) filePath(id string) string {
+	return filepath.Join(r.path, filepath.Base(id))
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository"
+)
+
+var _ verificationtokenrepository.Repository = (*Repository)(nil)
+
+type Repository struct {
+	mu     sync.Mutex
+	tokens map[string]*domain.VerificationToken
+}
+
+func NewRepository() *Repository {
+	return &Repository{
+		mu:     sync.Mutex{},
+		tokens: make(map[string]*domain.VerificationToken),
+	}
+}
+
+func (r *Repository) CreateVerificationToken(ctx context.Context, token *domain.VerificationToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[token.ID()]; ok {
+		return verificationtokenrepository.ErrVerificationTokenAlreadyExists
+	}
+
+	r.tokens[token.ID()] = token
+
+	return nil
+}
+
+func (r *Repository) GetVerificationToken(
+	ctx context.Context,
+	id string,
+	now time.Time,
+) (*domain.VerificationToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.tokens[id]
+	if !ok {
+		return nil, verificationtokenrepository.ErrVerificationTokenNotFound
+	}
+
+	if token.IsExpired(now) {
+		delete(r.tokens, id)
+
+		return nil, verificationtokenrepository.ErrVerificationTokenNotFound
+	}
+
+	return token, nil
+}
+
+func (r *Repository) DeleteVerificationToken(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[id]; !ok {
+		return verificationtokenrepository.ErrVerificationTokenNotFound
+	}
+
+	delete(r.tokens, id)
+
+	return nil
+}
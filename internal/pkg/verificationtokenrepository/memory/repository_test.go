@@ -0,0 +1,17 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository/memory"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.Run(t, func(_ *testing.T) verificationtokenrepository.Repository {
+		return memory.NewRepository()
+	})
+}
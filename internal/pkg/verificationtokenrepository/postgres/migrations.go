@@ -0,0 +1,16 @@
+package postgres
+
+// migrationsTable records the applied migrations of the verification tokens table.
+const migrationsTable = "verification_token_migrations"
+
+// migrations are applied in order by postgresdb.Migrate.
+// Never edit a released migration, append a new one instead.
+var migrations = []string{ //nolint:gochecknoglobals
+	`CREATE TABLE verification_tokens (
+		id         TEXT PRIMARY KEY,
+		username   TEXT NOT NULL,
+		email      TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL
+	)`,
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/postgresdb"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository"
+)
+
+var _ verificationtokenrepository.Repository = (*Repository)(nil)
+
+// Repository stores verification tokens in PostgreSQL.
+// Deleting the row is what uses the token up, the database lets only one delete succeed.
+type Repository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRepository stores verification tokens in the database of pool and migrates their table.
+// The pool stays open, it belongs to the caller, see postgresdb.Open.
+func NewRepository(ctx context.Context, pool *pgxpool.Pool) (*Repository, error) {
+	err := postgresdb.Migrate(ctx, pool, migrationsTable, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate verification tokens: %w", err)
+	}
+
+	return &Repository{pool: pool}, nil
+}
+
+func (r *Repository) CreateVerificationToken(ctx context.Context, token *domain.VerificationToken) error {
+	_, err := r.pool.Exec(ctx,
+		"INSERT INTO verification_tokens (id, username, email, created_at, expires_at) VALUES ($1, $2, $3, $4, $5)",
+		token.ID(), token.Username(), token.Email(), token.CreatedAt(), token.ExpiresAt())
+	if err != nil {
+		if postgresdb.IsUniqueViolation(err) {
+			return verificationtokenrepository.ErrVerificationTokenAlreadyExists
+		}
+
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetVerificationToken(
+	ctx context.Context,
+	id string,
+	now time.Time,
+) (*domain.VerificationToken, error) {
+	var (
+		username  string
+		email     string
+		createdAt time.Time
+		expiresAt time.Time
+	)
+
+	err := r.pool.QueryRow(ctx,
+		"SELECT username, email, created_at, expires_at FROM verification_tokens WHERE id = $1 AND expires_at >= $2",
+		id, now).Scan(&username, &email, &createdAt, &expiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, verificationtokenrepository.ErrVerificationTokenNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read verification token: %w", err)
+	}
+
+	return domain.NewVerificationToken(id, username, email, createdAt, expiresAt), nil
+}
+
+func (r *Repository) DeleteVerificationToken(ctx context.Context, id string) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM verification_tokens WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete verification token: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return verificationtokenrepository.ErrVerificationTokenNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package verificationtokenrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+)
+
+type Repository interface {
+	CreateVerificationToken(ctx context.Context, token *domain.VerificationToken) error
+	// GetVerificationToken returns ErrVerificationTokenNotFound for unknown tokens and for tokens expired at now.
+	GetVerificationToken(ctx context.Context, id string, now time.Time) (*domain.VerificationToken, error)
+	// DeleteVerificationToken returns ErrVerificationTokenNotFound if the token is already gone.
+	// Of concurrent deletes of the same token only one succeeds, which makes a token single-use.
+	DeleteVerificationToken(ctx context.Context, id string) error
+}
@@ -0,0 +1,225 @@
+package repositorytest
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/key-stone/internal/pkg/domain"
+	"github.com/neatflowcv/key-stone/internal/pkg/verificationtokenrepository"
+)
+
+// Check verifies one part of the verificationtokenrepository.Repository contract.
+// Checks use their own token IDs, so they can share a repository.
+// The IDs start with a random prefix, so a persistent repository can be verified again.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context, repo verificationtokenrepository.Repository) error
+}
+
+// Checks returns the whole contract every verificationtokenrepository.Repository must fulfil.
+// Every call draws a new prefix.
+func Checks() []Check {
+	prefix := rand.Text() + "-"
+	prefixed := func(
+		run func(ctx context.Context, repo verificationtokenrepository.Repository, prefix string) error,
+	) func(ctx context.Context, repo verificationtokenrepository.Repository) error {
+		return func(ctx context.Context, repo verificationtokenrepository.Repository) error {
+			return run(ctx, repo, prefix)
+		}
+	}
+
+	return []Check{
+		{Name: "create and get", Run: prefixed(checkCreateGet)},
+		{Name: "get missing", Run: prefixed(checkGetMissing)},
+		{Name: "create duplicate", Run: prefixed(checkCreateDuplicate)},
+		{Name: "get expired", Run: prefixed(checkGetExpired)},
+		{Name: "delete", Run: prefixed(checkDelete)},
+		{Name: "concurrent delete", Run: prefixed(checkConcurrentDelete)},
+	}
+}
+
+// Verify runs every check against repo.
+func Verify(ctx context.Context, repo verificationtokenrepository.Repository) error {
+	var errs []error
+
+	for _, check := range Checks() {
+		err := check.Run(ctx, repo)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", check.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run runs every check as a subtest against a fresh repository, like the one of credentialrepository.
+func Run(t *testing.T, newRepository func(t *testing.T) verificationtokenrepository.Repository) {
+	t.Helper()
+
+	for _, check := range Checks() {
+		t.Run(check.Name, func(t *testing.T) {
+			err := check.Run(t.Context(), newRepository(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// newToken returns a token of the user that expires in ttl, with times in whole seconds,
+// the precision every backend keeps.
+func newToken(id, username string, ttl time.Duration) *domain.VerificationToken {
+	now := time.Now().Truncate(time.Second)
+
+	return domain.NewVerificationToken(id, username, username+"@example.com", now, now.Add(ttl))
+}
+
+func checkCreateGet(ctx context.Context, repo verificationtokenrepository.Repository, prefix string) error {
+	token := newToken(prefix+"create", prefix+"alice", time.Hour)
+
+	err := repo.CreateVerificationToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	return expectToken(ctx, repo, token)
+}
+
+func checkGetMissing(ctx context.Context, repo verificationtokenrepository.Repository, prefix string) error {
+	_, err := repo.GetVerificationToken(ctx, prefix+"missing", time.Now())
+
+	return expectError(err, verificationtokenrepository.ErrVerificationTokenNotFound)
+}
+
+func checkCreateDuplicate(ctx context.Context, repo verificationtokenrepository.Repository, prefix string) error {
+	token := newToken(prefix+"duplicate", prefix+"alice", time.Hour)
+
+	err := repo.CreateVerificationToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	err = expectError(repo.CreateVerificationToken(ctx, newToken(token.ID(), prefix+"bob", time.Hour)),
+		verificationtokenrepository.ErrVerificationTokenAlreadyExists)
+	if err != nil {
+		return err
+	}
+
+	// the duplicate must not replace the token
+	return expectToken(ctx, repo, token)
+}
+
+func checkGetExpired(ctx context.Context, repo verificationtokenrepository.Repository, prefix string) error {
+	token := newToken(prefix+"expired", prefix+"alice", time.Hour)
+
+	err := repo.CreateVerificationToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	_, err = repo.GetVerificationToken(ctx, token.ID(), token.ExpiresAt().Add(time.Second))
+
+	return expectError(err, verificationtokenrepository.ErrVerificationTokenNotFound)
+}
+
+func checkDelete(ctx context.Context, repo verificationtokenrepository.Repository, prefix string) error {
+	token := newToken(prefix+"delete", prefix+"alice", time.Hour)
+
+	err := repo.CreateVerificationToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	err = repo.DeleteVerificationToken(ctx, token.ID())
+	if err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+
+	_, err = repo.GetVerificationToken(ctx, token.ID(), time.Now())
+
+	err = expectError(err, verificationtokenrepository.ErrVerificationTokenNotFound)
+	if err != nil {
+		return fmt.Errorf("get deleted: %w", err)
+	}
+
+	return expectError(repo.DeleteVerificationToken(ctx, token.ID()),
+		verificationtokenrepository.ErrVerificationTokenNotFound)
+}
+
+// checkConcurrentDelete lets workers use the same token at once, only one of them may succeed.
+func checkConcurrentDelete(ctx context.Context, repo verificationtokenrepository.Repository, prefix string) error {
+	const workers = 8
+
+	token := newToken(prefix+"concurrent", prefix+"alice", time.Hour)
+
+	err := repo.CreateVerificationToken(ctx, token)
+	if err != nil {
+		return fmt.Errorf("create: %w", err)
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		errs      []error
+	)
+
+	for range workers {
+		wg.Go(func() {
+			err := repo.DeleteVerificationToken(ctx, token.ID())
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case err == nil:
+				succeeded++
+			case !errors.Is(err, verificationtokenrepository.ErrVerificationTokenNotFound):
+				errs = append(errs, err)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if succeeded != 1 {
+		return fmt.Errorf("%w: %d deletes used the same token", ErrContractViolated, succeeded)
+	}
+
+	return nil
+}
+
+func expectToken(
+	ctx context.Context,
+	repo verificationtokenrepository.Repository,
+	want *domain.VerificationToken,
+) error {
+	got, err := repo.GetVerificationToken(ctx, want.ID(), time.Now())
+	if err != nil {
+		return fmt.Errorf("get %q: %w", want.ID(), err)
+	}
+
+	if got.ID() != want.ID() || got.Username() != want.Username() || got.Email() != want.Email() ||
+		!got.CreatedAt().Equal(want.CreatedAt()) || !got.ExpiresAt().Equal(want.ExpiresAt()) {
+		return fmt.Errorf("%w: got %+v, want %+v", ErrContractViolated, got, want)
+	}
+
+	return nil
+}
+
+func expectError(err, want error) error {
+	if !errors.Is(err, want) {
+		return fmt.Errorf("%w: got error %v, want %v", ErrContractViolated, err, want)
+	}
+
+	return nil
+}
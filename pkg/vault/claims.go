@@ -0,0 +1,122 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims are the registered JWT claims, the session and the client the token belongs to, the granted scope
+// and any custom claims. Custom claims are stored next to the registered claims in the token payload.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	SessionID string         `json:"sid,omitempty"`
+	ClientID  string         `json:"client_id,omitempty"`
+	Scope     string         `json:"scope,omitempty"`
+	Custom    map[string]any `json:"-"`
+}
+
+// registeredClaims is Claims without its JSON methods.
+type registeredClaims struct {
+	jwt.RegisteredClaims
+
+	SessionID string `json:"sid,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+}
+
+func (c Claims) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(registeredClaims{
+		RegisteredClaims: c.RegisteredClaims,
+		SessionID:        c.SessionID,
+		ClientID:         c.ClientID,
+		Scope:            c.Scope,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal registered claims: %w", err)
+	}
+
+	if len(c.Custom) == 0 {
+		return data, nil
+	}
+
+	var payload map[string]any
+
+	err = json.Unmarshal(data, &payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal registered claims: %w", err)
+	}
+
+	for name, value := range c.Custom {
+		if _, ok := payload[name]; ok {
+			return nil, fmt.Errorf("custom claim %q collides with a registered claim: %w", name, ErrInvalidToken)
+		}
+
+		payload[name] = value
+	}
+
+	return json.Marshal(payload) //nolint:wrapcheck
+}
+
+func (c *Claims) UnmarshalJSON(data []byte) error {
+	var registered registeredClaims
+
+	err := json.Unmarshal(data, &registered)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal registered claims: %w", err)
+	}
+
+	var payload map[string]any
+
+	err = json.Unmarshal(data, &payload)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal custom claims: %w", err)
+	}
+
+	for _, name := range []string{"iss", "sub", "aud", "exp", "nbf", "iat", "jti", "sid", "client_id", "scope"} {
+		delete(payload, name)
+	}
+
+	c.RegisteredClaims = registered.RegisteredClaims
+	c.SessionID = registered.SessionID
+	c.ClientID = registered.ClientID
+	c.Scope = registered.Scope
+	c.Custom = nil
+
+	if len(payload) > 0 {
+		c.Custom = payload
+	}
+
+	return nil
+}
+
+// Scopes returns the space separated scope as a list.
+func (c *Claims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// VerifyAudience returns ErrInvalidAudience unless the token was issued for the audience.
+func (c *Claims) VerifyAudience(audience string) error {
+	if !slices.Contains(c.Audience, audience) {
+		return fmt.Errorf("token is not issued for %q: %w", audience, ErrInvalidAudience)
+	}
+
+	return nil
+}
+
+// VerifyScopes returns ErrInsufficientScope unless every required scope was granted.
+func (c *Claims) VerifyScopes(required ...string) error {
+	granted := c.Scopes()
+
+	for _, scope := range required {
+		if !slices.Contains(granted, scope) {
+			return fmt.Errorf("scope %q is not granted: %w", scope, ErrInsufficientScope)
+		}
+	}
+
+	return nil
+}
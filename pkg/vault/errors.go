@@ -4,4 +4,13 @@ import "errors"
 
 var (
 	ErrInvalidToken = errors.New("invalid token")
+	ErrInvalidKey   = errors.New("invalid key")
+
+	ErrInvalidAudience   = errors.New("invalid audience")
+	ErrInsufficientScope = errors.New("insufficient scope")
+
+	ErrKeyNotFound      = errors.New("key not found")
+	ErrKeyAlreadyExists = errors.New("key already exists")
+	ErrKeyCannotSign    = errors.New("key cannot sign")
+	ErrKeyActive        = errors.New("key is active")
 )
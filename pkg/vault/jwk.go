@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+)
+
+// JSONWebKey is the public part of a signing key as described in RFC 7517.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+	KeyID     string `json:"kid,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
+func newJSONWebKey(publicKey any, algorithm, keyID string) (*JSONWebKey, bool) {
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		return &JSONWebKey{ //nolint:exhaustruct
+			KeyType:   "RSA",
+			Use:       "sig",
+			Algorithm: algorithm,
+			KeyID:     keyID,
+			N:         encodeSegment(publicKey.N.Bytes()),
+			E:         encodeSegment(big.NewInt(int64(publicKey.E)).Bytes()),
+		}, true
+	case *ecdsa.PublicKey:
+		ecdhKey, err := publicKey.ECDH()
+		if err != nil {
+			return nil, false
+		}
+
+		// uncompressed point: 0x04 || X || Y
+		point := ecdhKey.Bytes()[1:]
+		size := len(point) / 2 //nolint:mnd
+
+		return &JSONWebKey{ //nolint:exhaustruct
+			KeyType:   "EC",
+			Use:       "sig",
+			Algorithm: algorithm,
+			KeyID:     keyID,
+			Curve:     publicKey.Curve.Params().Name,
+			X:         encodeSegment(point[:size]),
+			Y:         encodeSegment(point[size:]),
+		}, true
+	case ed25519.PublicKey:
+		return &JSONWebKey{ //nolint:exhaustruct
+			KeyType:   "OKP",
+			Use:       "sig",
+			Algorithm: algorithm,
+			KeyID:     keyID,
+			Curve:     "Ed25519",
+			X:         encodeSegment(publicKey),
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+// thumbprint computes the RFC 7638 thumbprint of a public key.
+// It hashes the required members of the JSON Web Key in lexicographic order.
+func thumbprint(publicKey any) string {
+	key, ok := newJSONWebKey(publicKey, "", "")
+	if !ok {
+		return ""
+	}
+
+	var members string
+
+	switch key.KeyType {
+	case "RSA":
+		members = fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, key.E, key.N)
+	case "EC":
+		members = fmt.Sprintf(`{"crv":%q,"kty":"EC","x":%q,"y":%q}`, key.Curve, key.X, key.Y)
+	default:
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, key.Curve, key.KeyType, key.X)
+	}
+
+	sum := sha256.Sum256([]byte(members))
+
+	return encodeSegment(sum[:])
+}
+
+func encodeSegment(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
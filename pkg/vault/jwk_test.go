@@ -0,0 +1,159 @@
+package vault_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/key-stone/pkg/vault"
+)
+
+func TestThumbprintMatchesRFC7638(t *testing.T) {
+	t.Parallel()
+
+	// the example key of RFC 7638 section 3.1
+	n, err := base64.RawURLEncoding.DecodeString(
+		"0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjB" +
+			"ZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8" +
+			"KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_" +
+			"xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: 65537}
+
+	key, err := vault.ParseKeyPEM(publicKeyPEM(t, publicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const want = "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs"
+	if key.ID() != want {
+		t.Fatalf("got %s, want %s", key.ID(), want)
+	}
+}
+
+func TestPrivateAndPublicHalvesShareTheID(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edPublic, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		privateKey any
+		publicKey  any
+	}{
+		{name: "rsa", privateKey: rsaKey, publicKey: &rsaKey.PublicKey},
+		{name: "ecdsa", privateKey: ecKey, publicKey: &ecKey.PublicKey},
+		{name: "ed25519", privateKey: edKey, publicKey: edPublic},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			private := parsePrivateKey(t, tt.privateKey)
+
+			public, err := vault.ParseKeyPEM(publicKeyPEM(t, tt.publicKey))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if private.ID() != public.ID() {
+				t.Fatalf("got %s for the private half, %s for the public half", private.ID(), public.ID())
+			}
+
+			if public.CanSign() {
+				t.Fatal("a public key can sign")
+			}
+		})
+	}
+}
+
+func TestPublicKeysHoldOnlyPublicMaterial(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := []*vault.Key{
+		parsePrivateKey(t, rsaKey),
+		parsePrivateKey(t, ecKey),
+		parsePrivateKey(t, edKey),
+	}
+	hmac := vault.NewHMACKey("hmac", []byte("secret"))
+	v := vault.NewVault(issuer, newRing(t, hmac, keys...))
+
+	data, err := json.Marshal(v.PublicKeys(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var published []map[string]string
+
+	err = json.Unmarshal(data, &published)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(published) != len(keys) {
+		t.Fatalf("got %d keys, want %d without the symmetric key", len(published), len(keys))
+	}
+
+	public := map[string]bool{"kty": true, "use": true, "alg": true, "kid": true, "n": true, "e": true,
+		"crv": true, "x": true, "y": true}
+	ids := map[string]bool{}
+
+	for _, jwk := range published {
+		for member := range jwk {
+			if !public[member] {
+				t.Fatalf("key %s publishes %q", jwk["kid"], member)
+			}
+		}
+
+		ids[jwk["kid"]] = true
+	}
+
+	for _, key := range keys {
+		if !ids[key.ID()] {
+			t.Fatalf("key %s is not published", key.ID())
+		}
+	}
+
+	if ids[hmac.ID()] {
+		t.Fatal("the symmetric key is published")
+	}
+}
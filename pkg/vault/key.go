@@ -0,0 +1,185 @@
+package vault
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Key is a signing key together with the method used to sign with it.
+// Symmetric keys sign and verify with the same secret, asymmetric keys sign
+// with the private half and verify with the public half.
+// The ID of an asymmetric key is its RFC 7638 thumbprint, a symmetric key is given its ID.
+type Key struct {
+	id           string
+	method       jwt.SigningMethod
+	signingKey   any
+	verifyingKey any
+}
+
+// NewHMACKey returns a HS256 key with the given ID using secret for both signing and verifying.
+// The ID is not derived from the secret: the kid header of every token would otherwise carry a hash
+// anyone could test guesses of the secret against.
+func NewHMACKey(id string, secret []byte) *Key {
+	return &Key{
+		id:           id,
+		method:       jwt.SigningMethodHS256,
+		signingKey:   secret,
+		verifyingKey: secret,
+	}
+}
+
+// ParseKeyPEM parses a PEM encoded private or public key.
+// Keys parsed from a public key can only verify tokens.
+func ParseKeyPEM(data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found: %w", ErrInvalidKey)
+	}
+
+	if block.Type != "PUBLIC KEY" {
+		return ParsePrivateKeyPEM(data)
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	var method jwt.SigningMethod
+
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		method = jwt.SigningMethodRS256
+	case *ecdsa.PublicKey:
+		method, err = ecdsaMethod(publicKey.Curve)
+		if err != nil {
+			return nil, err
+		}
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T: %w", publicKey, ErrInvalidKey)
+	}
+
+	return &Key{
+		id:           thumbprint(publicKey),
+		method:       method,
+		signingKey:   nil,
+		verifyingKey: publicKey,
+	}, nil
+}
+
+// ParsePrivateKeyPEM parses a PEM encoded RSA, ECDSA or Ed25519 private key.
+// The signing method is derived from the key type: RS256 for RSA, ES256,
+// ES384 or ES512 depending on the curve for ECDSA and EdDSA for Ed25519.
+func ParsePrivateKeyPEM(data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found: %w", ErrInvalidKey)
+	}
+
+	privateKey, err := parsePrivateKey(block)
+	if err != nil {
+		return nil, err
+	}
+
+	switch privateKey := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return &Key{
+			id:           thumbprint(&privateKey.PublicKey),
+			method:       jwt.SigningMethodRS256,
+			signingKey:   privateKey,
+			verifyingKey: &privateKey.PublicKey,
+		}, nil
+	case *ecdsa.PrivateKey:
+		method, err := ecdsaMethod(privateKey.Curve)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Key{
+			id:           thumbprint(&privateKey.PublicKey),
+			method:       method,
+			signingKey:   privateKey,
+			verifyingKey: &privateKey.PublicKey,
+		}, nil
+	case ed25519.PrivateKey:
+		return &Key{
+			id:           thumbprint(privateKey.Public()),
+			method:       jwt.SigningMethodEdDSA,
+			signingKey:   privateKey,
+			verifyingKey: privateKey.Public(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T: %w", privateKey, ErrInvalidKey)
+	}
+}
+
+// ID returns the key ID stamped into the "kid" header of tokens signed with the key.
+func (k *Key) ID() string {
+	return k.id
+}
+
+// CanSign reports whether the key holds signing material.
+func (k *Key) CanSign() bool {
+	return k.signingKey != nil
+}
+
+// Algorithm returns the JWS algorithm name of the key, e.g. "RS256".
+func (k *Key) Algorithm() string {
+	return k.method.Alg()
+}
+
+// JSONWebKey returns the public half of the key as a JSON Web Key.
+// Symmetric keys have no public half and return false.
+func (k *Key) JSONWebKey() (*JSONWebKey, bool) {
+	return newJSONWebKey(k.verifyingKey, k.method.Alg(), k.id)
+}
+
+func parsePrivateKey(block *pem.Block) (any, error) {
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PKCS#1 private key: %w", err)
+		}
+
+		return key, nil
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse EC private key: %w", err)
+		}
+
+		return key, nil
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PKCS#8 private key: %w", err)
+		}
+
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q: %w", block.Type, ErrInvalidKey)
+	}
+}
+
+func ecdsaMethod(curve elliptic.Curve) (jwt.SigningMethod, error) {
+	switch curve {
+	case elliptic.P256():
+		return jwt.SigningMethodES256, nil
+	case elliptic.P384():
+		return jwt.SigningMethodES384, nil
+	case elliptic.P521():
+		return jwt.SigningMethodES512, nil
+	default:
+		return nil, fmt.Errorf("unsupported curve %s: %w", curve.Params().Name, ErrInvalidKey)
+	}
+}
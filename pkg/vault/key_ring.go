@@ -0,0 +1,195 @@
+package vault
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KeyRing holds the keys of a vault. Exactly one key is active and signs new
+// tokens, every other key is verify-only so tokens signed before a rotation
+// stay valid. A verify-only key can be retired, after which it is dropped
+// once its retirement time has passed.
+//
+// KeyRing is safe for concurrent use, so keys can be added, activated and
+// retired while the vault is serving requests.
+type KeyRing struct {
+	mu       sync.RWMutex
+	keys     map[string]*Key
+	retireAt map[string]time.Time
+	activeID string
+}
+
+// NewKeyRing returns a key ring signing with active and verifying with active and verifyOnly.
+func NewKeyRing(active *Key, verifyOnly ...*Key) (*KeyRing, error) {
+	ring := &KeyRing{ //nolint:exhaustruct
+		keys:     make(map[string]*Key),
+		retireAt: make(map[string]time.Time),
+	}
+
+	err := ring.Replace(active, verifyOnly...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ring, nil
+}
+
+// Replace swaps every key of the ring at once. Removed keys stop verifying right away, Reload retires them instead.
+func (r *KeyRing) Replace(active *Key, verifyOnly ...*Key) error {
+	if !active.CanSign() {
+		return fmt.Errorf("key %s: %w", active.ID(), ErrKeyCannotSign)
+	}
+
+	keys := map[string]*Key{active.ID(): active}
+	for _, key := range verifyOnly {
+		keys[key.ID()] = key
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys = keys
+	r.retireAt = make(map[string]time.Time)
+	r.activeID = active.ID()
+
+	return nil
+}
+
+// Reload makes the ring hold the keys of a reloaded key set, e.g. after key files changed.
+// Keys missing from the set are retired at retireAt rather than dropped, so tokens they signed keep verifying
+// until then. Keys of the set that were being retired are kept again.
+func (r *KeyRing) Reload(active *Key, verifyOnly []*Key, retireAt time.Time) error {
+	if !active.CanSign() {
+		return fmt.Errorf("key %s: %w", active.ID(), ErrKeyCannotSign)
+	}
+
+	keys := map[string]*Key{active.ID(): active}
+	for _, key := range verifyOnly {
+		keys[key.ID()] = key
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id := range r.keys {
+		_, kept := keys[id]
+		_, retiring := r.retireAt[id]
+
+		if !kept && !retiring {
+			r.retireAt[id] = retireAt
+		}
+	}
+
+	for id, key := range keys {
+		r.keys[id] = key
+		delete(r.retireAt, id)
+	}
+
+	r.activeID = active.ID()
+
+	return nil
+}
+
+// Add adds a verify-only key. Publishing a key before activating it gives
+// verifiers time to pick it up.
+func (r *KeyRing) Add(key *Key) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.keys[key.ID()]; ok {
+		return fmt.Errorf("key %s: %w", key.ID(), ErrKeyAlreadyExists)
+	}
+
+	r.keys[key.ID()] = key
+
+	return nil
+}
+
+// Activate makes the key with the given ID the signing key.
+// The previously active key stays in the ring as a verify-only key.
+func (r *KeyRing) Activate(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return fmt.Errorf("key %s: %w", id, ErrKeyNotFound)
+	}
+
+	if !key.CanSign() {
+		return fmt.Errorf("key %s: %w", id, ErrKeyCannotSign)
+	}
+
+	r.activeID = id
+	delete(r.retireAt, id)
+
+	return nil
+}
+
+// Retire schedules the removal of a verify-only key. The key keeps verifying
+// tokens until the given time, which should be past the expiry of the last
+// token it signed.
+func (r *KeyRing) Retire(id string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.keys[id]; !ok {
+		return fmt.Errorf("key %s: %w", id, ErrKeyNotFound)
+	}
+
+	if id == r.activeID {
+		return fmt.Errorf("key %s: %w", id, ErrKeyActive)
+	}
+
+	r.retireAt[id] = until
+
+	return nil
+}
+
+func (r *KeyRing) active() *Key {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.keys[r.activeID]
+}
+
+func (r *KeyRing) lookup(id string, now time.Time) (*Key, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.prune(now)
+
+	key, ok := r.keys[id]
+
+	return key, ok
+}
+
+func (r *KeyRing) list(now time.Time) []*Key {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.prune(now)
+
+	ret := make([]*Key, 0, len(r.keys))
+	for _, key := range r.keys {
+		ret = append(ret, key)
+	}
+
+	slices.SortFunc(ret, func(a, b *Key) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+
+	return ret
+}
+
+func (r *KeyRing) prune(now time.Time) {
+	for id, until := range r.retireAt {
+		if now.After(until) {
+			delete(r.keys, id)
+			delete(r.retireAt, id)
+		}
+	}
+}
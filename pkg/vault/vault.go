@@ -9,55 +9,106 @@ import (
 )
 
 type Vault struct {
-	issuer    string
-	secretKey []byte
+	issuer string
+	keys   *KeyRing
 }
 
-func NewVault(issuer string, secretKey []byte) *Vault {
+func NewVault(issuer string, keys *KeyRing) *Vault {
 	return &Vault{
-		issuer:    issuer,
-		secretKey: secretKey,
+		issuer: issuer,
+		keys:   keys,
 	}
 }
 
-func (v *Vault) Encrypt(subject string, now time.Time, duration time.Duration) string {
-	expiresAt := now.Add(duration)
-	claim := &jwt.RegisteredClaims{
-		Issuer:    v.issuer,
-		Subject:   subject,
-		Audience:  jwt.ClaimStrings{},
-		ExpiresAt: jwt.NewNumericDate(expiresAt),
-		NotBefore: jwt.NewNumericDate(now),
-		IssuedAt:  jwt.NewNumericDate(now),
-		ID:        "",
+// Encrypt signs the claims with the active key. The issuer is always set to the issuer of the vault.
+// Signing fails if the claims cannot be encoded as JSON, e.g. a custom claim holds a channel,
+// or the signer fails, e.g. an RSA key rejected by crypto/rsa.
+func (v *Vault) Encrypt(claims *Claims) (string, error) {
+	claims.Issuer = v.issuer
+	key := v.keys.active()
+	accessToken := jwt.NewWithClaims(key.method, claims)
+	accessToken.Header["kid"] = key.ID()
+
+	token, err := accessToken.SignedString(key.signingKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (v *Vault) Decrypt(token string, now time.Time) (*Claims, error) {
+	return v.decrypt(token, now, jwt.WithTimeFunc(func() time.Time { return now }))
+}
+
+// Verify decrypts a token meant for the audience that grants every required scope.
+// Returns ErrInvalidAudience or ErrInsufficientScope if the token is valid but not meant for the caller.
+func (v *Vault) Verify(token string, now time.Time, audience string, scopes ...string) (*Claims, error) {
+	claims, err := v.Decrypt(token, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = claims.VerifyAudience(audience)
+	if err != nil {
+		return nil, err
 	}
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claim)
 
-	token, err := accessToken.SignedString(v.secretKey)
+	err = claims.VerifyScopes(scopes...)
 	if err != nil {
-		panic(err) // 어떻게 발생하지? 가능한가?
+		return nil, err
 	}
 
-	return token
+	return claims, nil
 }
 
-func (v *Vault) Decrypt(token string, now time.Time) (string, error) {
-	var claims jwt.RegisteredClaims
+// Inspect verifies the signature and the issuer of a token like Decrypt,
+// but accepts the token even if it has expired or is not valid yet.
+func (v *Vault) Inspect(token string, now time.Time) (*Claims, error) {
+	return v.decrypt(token, now, jwt.WithoutClaimsValidation())
+}
+
+func (v *Vault) decrypt(token string, now time.Time, option jwt.ParserOption) (*Claims, error) {
+	var claims Claims
 
 	_, err := jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		id, _ := token.Header["kid"].(string)
+
+		key, ok := v.keys.lookup(id, now)
+		if !ok {
+			return nil, fmt.Errorf("unknown key %q: %w", id, ErrInvalidToken)
+		}
+
+		if token.Method.Alg() != key.method.Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %w", ErrInvalidToken)
 		}
 
-		return v.secretKey, nil
-	}, jwt.WithTimeFunc(func() time.Time { return now }))
+		return key.verifyingKey, nil
+	}, option)
 	if err != nil {
-		return "", errors.Join(ErrInvalidToken, err)
+		return nil, errors.Join(ErrInvalidToken, err)
 	}
 
 	if claims.Issuer != v.issuer {
-		return "", fmt.Errorf("invalid issuer: %w", ErrInvalidToken)
+		return nil, fmt.Errorf("invalid issuer: %w", ErrInvalidToken)
+	}
+
+	return &claims, nil
+}
+
+// PublicKeys returns the keys that verify tokens of this vault.
+// Symmetric keys are never published.
+func (v *Vault) PublicKeys(now time.Time) []JSONWebKey {
+	var ret []JSONWebKey
+
+	for _, key := range v.keys.list(now) {
+		jwk, ok := key.JSONWebKey()
+		if !ok {
+			continue
+		}
+
+		ret = append(ret, *jwk)
 	}
 
-	return claims.Subject, nil
+	return ret
 }
@@ -0,0 +1,409 @@
+package vault_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/neatflowcv/key-stone/pkg/vault"
+)
+
+const issuer = "key-stone"
+
+func newClaims(now time.Time) *vault.Claims {
+	return &vault.Claims{ //nolint:exhaustruct
+		RegisteredClaims: jwt.RegisteredClaims{ //nolint:exhaustruct
+			Subject:   "alice",
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(24 * time.Hour)),
+		},
+	}
+}
+
+// parsePrivateKey returns the vault key of a generated private key, read back from PKCS#8 PEM.
+func parsePrivateKey(t *testing.T, privateKey any) *vault.Key {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := vault.ParseKeyPEM(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+func publicKeyPEM(t *testing.T, publicKey any) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func newRing(t *testing.T, active *vault.Key, verifyOnly ...*vault.Key) *vault.KeyRing {
+	t.Helper()
+
+	ring, err := vault.NewKeyRing(active, verifyOnly...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ring
+}
+
+func encrypt(t *testing.T, v *vault.Vault, now time.Time) string {
+	t.Helper()
+
+	token, err := v.Encrypt(newClaims(now))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func TestEncryptAndDecrypt(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		key       *vault.Key
+		algorithm string
+	}{
+		{name: "hmac", key: vault.NewHMACKey("hmac", []byte("secret")), algorithm: "HS256"},
+		{name: "rsa", key: parsePrivateKey(t, rsaKey), algorithm: "RS256"},
+		{name: "ecdsa", key: parsePrivateKey(t, ecKey), algorithm: "ES384"},
+		{name: "ed25519", key: parsePrivateKey(t, edKey), algorithm: "EdDSA"},
+	}
+
+	now := time.Now()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.key.Algorithm() != tt.algorithm {
+				t.Fatalf("got %s, want %s", tt.key.Algorithm(), tt.algorithm)
+			}
+
+			v := vault.NewVault(issuer, newRing(t, tt.key))
+
+			claims, err := v.Decrypt(encrypt(t, v, now), now)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if claims.Subject != "alice" || claims.Issuer != issuer {
+				t.Fatalf("got subject %q and issuer %q", claims.Subject, claims.Issuer)
+			}
+
+			_, err = v.Decrypt(encrypt(t, v, now), now.Add(25*time.Hour))
+			if !errors.Is(err, vault.ErrInvalidToken) {
+				t.Fatalf("got %v for an expired token, want %v", err, vault.ErrInvalidToken)
+			}
+
+			other := vault.NewVault("other", newRing(t, tt.key))
+
+			_, err = other.Decrypt(encrypt(t, v, now), now)
+			if !errors.Is(err, vault.ErrInvalidToken) {
+				t.Fatalf("got %v for another issuer, want %v", err, vault.ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func TestHMACKeyID(t *testing.T) {
+	t.Parallel()
+
+	key := vault.NewHMACKey("2026-10", []byte("secret"))
+	if key.ID() != "2026-10" {
+		t.Fatalf("got %q, want %q", key.ID(), "2026-10")
+	}
+
+	if _, ok := key.JSONWebKey(); ok {
+		t.Fatal("a symmetric key has a JSON Web Key")
+	}
+}
+
+func TestUnknownKey(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	signer := vault.NewVault(issuer, newRing(t, vault.NewHMACKey("old", []byte("secret"))))
+	verifier := vault.NewVault(issuer, newRing(t, vault.NewHMACKey("new", []byte("secret"))))
+
+	_, err := verifier.Decrypt(encrypt(t, signer, now), now)
+	if !errors.Is(err, vault.ErrInvalidToken) {
+		t.Fatalf("got %v, want %v", err, vault.ErrInvalidToken)
+	}
+
+	// the same kid with another secret fails on the signature
+	forger := vault.NewVault(issuer, newRing(t, vault.NewHMACKey("new", []byte("guessed"))))
+
+	_, err = verifier.Decrypt(encrypt(t, forger, now), now)
+	if !errors.Is(err, vault.ErrInvalidToken) {
+		t.Fatalf("got %v, want %v", err, vault.ErrInvalidToken)
+	}
+}
+
+func TestRetiredKey(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	oldKey := vault.NewHMACKey("old", []byte("old secret"))
+	newKey := vault.NewHMACKey("new", []byte("new secret"))
+	ring := newRing(t, oldKey)
+	v := vault.NewVault(issuer, ring)
+	token := encrypt(t, v, now)
+
+	err := ring.Reload(newKey, nil, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = v.Decrypt(token, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("got %v during the grace period", err)
+	}
+
+	_, err = v.Decrypt(token, now.Add(2*time.Hour))
+	if !errors.Is(err, vault.ErrInvalidToken) {
+		t.Fatalf("got %v after the grace period, want %v", err, vault.ErrInvalidToken)
+	}
+
+	// a pruned key stays gone even if the clock is turned back
+	_, err = v.Decrypt(token, now.Add(time.Minute))
+	if !errors.Is(err, vault.ErrInvalidToken) {
+		t.Fatalf("got %v for a pruned key, want %v", err, vault.ErrInvalidToken)
+	}
+
+	_, err = v.Decrypt(encrypt(t, v, now), now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("got %v for a token of the new key", err)
+	}
+}
+
+func TestKeyRingRetire(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	oldKey := vault.NewHMACKey("old", []byte("old secret"))
+	newKey := vault.NewHMACKey("new", []byte("new secret"))
+	ring := newRing(t, oldKey, newKey)
+	v := vault.NewVault(issuer, ring)
+	token := encrypt(t, v, now)
+
+	err := ring.Retire("old", now)
+	if !errors.Is(err, vault.ErrKeyActive) {
+		t.Fatalf("got %v retiring the active key, want %v", err, vault.ErrKeyActive)
+	}
+
+	err = ring.Retire("missing", now)
+	if !errors.Is(err, vault.ErrKeyNotFound) {
+		t.Fatalf("got %v retiring a missing key, want %v", err, vault.ErrKeyNotFound)
+	}
+
+	err = ring.Activate("new")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ring.Retire("old", now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = v.Decrypt(token, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("got %v before the retirement", err)
+	}
+
+	_, err = v.Decrypt(token, now.Add(2*time.Hour))
+	if !errors.Is(err, vault.ErrInvalidToken) {
+		t.Fatalf("got %v after the retirement, want %v", err, vault.ErrInvalidToken)
+	}
+}
+
+func TestKeyRingReloadKeepsReturningKeys(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	oldKey := vault.NewHMACKey("old", []byte("old secret"))
+	newKey := vault.NewHMACKey("new", []byte("new secret"))
+	ring := newRing(t, oldKey)
+	v := vault.NewVault(issuer, ring)
+	token := encrypt(t, v, now)
+
+	err := ring.Reload(newKey, nil, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the old key is back in the key set, so it is no longer retired
+	err = ring.Reload(newKey, []*vault.Key{oldKey}, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = v.Decrypt(token, now.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("got %v for a key that was reloaded again", err)
+	}
+}
+
+func TestKeyRingRejectsVerifyOnlyActiveKey(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	public, err := vault.ParseKeyPEM(publicKeyPEM(t, &rsaKey.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = vault.NewKeyRing(public)
+	if !errors.Is(err, vault.ErrKeyCannotSign) {
+		t.Fatalf("got %v, want %v", err, vault.ErrKeyCannotSign)
+	}
+}
+
+func TestAlgorithmConfusion(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		key       *vault.Key
+		publicKey any
+	}{
+		{name: "rsa", key: parsePrivateKey(t, rsaKey), publicKey: &rsaKey.PublicKey},
+		{name: "ecdsa", key: parsePrivateKey(t, ecKey), publicKey: &ecKey.PublicKey},
+	}
+
+	now := time.Now()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v := vault.NewVault(issuer, newRing(t, tt.key))
+			claims := newClaims(now)
+			claims.Issuer = issuer
+
+			// an attacker knows the public key and signs with it as an HMAC secret under the kid of the key
+			forged := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+			forged.Header["kid"] = tt.key.ID()
+
+			token, err := forged.SignedString(publicKeyPEM(t, tt.publicKey))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = v.Decrypt(token, now)
+			if !errors.Is(err, vault.ErrInvalidToken) {
+				t.Fatalf("got %v for HS256, want %v", err, vault.ErrInvalidToken)
+			}
+
+			unsigned := jwt.NewWithClaims(jwt.SigningMethodNone, claims)
+			unsigned.Header["kid"] = tt.key.ID()
+
+			token, err = unsigned.SignedString(jwt.UnsafeAllowNoneSignatureType)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = v.Decrypt(token, now)
+			if !errors.Is(err, vault.ErrInvalidToken) {
+				t.Fatalf("got %v for none, want %v", err, vault.ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	v := vault.NewVault(issuer, newRing(t, vault.NewHMACKey("hmac", []byte("secret"))))
+	claims := newClaims(now)
+	claims.Audience = jwt.ClaimStrings{"billing", "key-stone"}
+	claims.Scope = "read write"
+
+	token, err := v.Encrypt(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		audience string
+		scopes   []string
+		want     error
+	}{
+		{name: "audience", audience: "key-stone", scopes: nil, want: nil},
+		{name: "other audience of the token", audience: "billing", scopes: nil, want: nil},
+		{name: "audience the token is not for", audience: "shipping", scopes: nil, want: vault.ErrInvalidAudience},
+		{name: "granted scopes", audience: "billing", scopes: []string{"write", "read"}, want: nil},
+		{name: "scope that is not granted", audience: "billing", scopes: []string{"read", "admin"},
+			want: vault.ErrInsufficientScope},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := v.Verify(token, now, tt.audience, tt.scopes...)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	_, err = v.Verify(token, now.Add(25*time.Hour), "billing")
+	if !errors.Is(err, vault.ErrInvalidToken) {
+		t.Fatalf("got %v for an expired token, want %v", err, vault.ErrInvalidToken)
+	}
+}